@@ -4,6 +4,9 @@ import (
 	"errors"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/nebula-api/database"
@@ -28,34 +31,60 @@ func main() {
 	// Init
 	logrus.Printf("[NEBULA] Starting Nebula Server...")
 
-	svc := &server.Services{}
-
-	// IP Filter
-	isIPFilter := os.Getenv("ENABLE_IP_FILTER")
-	if isIPFilter == "true" {
-		dbipToken := os.Getenv("DB_IP_TOKEN")
-		if len(dbipToken) == 0 {
-			logrus.Errorf("[IPFilter] IPFilter enabled, but DB_IP_TOKEN does not provided!!")
-			panic(errors.New("DB_IP_TOKEN is null"))
+	svc := &server.Services{
+		ProxyTimeout: 30 * time.Second,
+	}
+	if t := os.Getenv("PROXY_TIMEOUT"); len(t) != 0 {
+		d, err := time.ParseDuration(t)
+		if err != nil {
+			logrus.WithError(err).Fatalf("[Proxy] Invalid PROXY_TIMEOUT: %s", t)
 		}
+		svc.ProxyTimeout = d
+	}
 
-		ipfw, err := service.NewIPFilter(&service.IPFilterConfig{
-			DBIPToken: dbipToken,
-		})
+	// Stream Publisher
+	codecs, err := stream.ParseCodecs(os.Getenv("STREAM_ENCODINGS"))
+	if err != nil {
+		logrus.WithError(err).Fatalf("[Stream] Invalid STREAM_ENCODINGS")
+	}
+	switch broker := os.Getenv("STREAM_BROKER"); broker {
+	case "", "redis":
+		transport, err := stream.ParseTransport(os.Getenv("STREAM_TRANSPORT"))
 		if err != nil {
-			panic(err)
+			logrus.WithError(err).Fatalf("[Redis] Invalid STREAM_TRANSPORT")
+		}
+		streamMaxLen := int64(10000)
+		if l := os.Getenv("STREAM_MAXLEN"); len(l) != 0 {
+			streamMaxLen, err = strconv.ParseInt(l, 10, 64)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[Redis] Invalid STREAM_MAXLEN: %s", l)
+			}
 		}
-		svc.IPFilter = ipfw
-	}
 
-	// Redis
-	go func() {
 		redisAddr := os.Getenv("REDIS_ADDRESS")
 		if len(redisAddr) == 0 {
 			redisAddr = "localhost:6379"
 		}
-		stream.NewRedisPool(redisAddr)
-	}()
+		svc.Publisher = stream.NewRedisPublisher(redisAddr, &stream.RedisConfig{
+			Transport:    transport,
+			StreamMaxLen: streamMaxLen,
+			Codecs:       codecs,
+		})
+	case "nats":
+		natsURL := os.Getenv("NATS_URL")
+		if len(natsURL) == 0 {
+			natsURL = "nats://localhost:4222"
+		}
+		natsPublisher, err := stream.NewNATSPublisher(natsURL, &stream.NATSConfig{
+			Codecs: codecs,
+		})
+		if err != nil {
+			logrus.WithError(err).Fatalf("[NATS] Failed to connect")
+		}
+		svc.Publisher = natsPublisher
+	default:
+		logrus.Fatalf("[Stream] Unknown STREAM_BROKER: %s", broker)
+	}
 
 	// Connect to MySQL
 	mysqlConStr := os.Getenv("MYSQL_CONNECTION_STRING")
@@ -65,6 +94,189 @@ func main() {
 	mysqlClient := database.NewMysqlClient(mysqlConStr, "nebula")
 	svc.MySQL = mysqlClient
 
+	// IP Filter
+	isIPFilter := os.Getenv("ENABLE_IP_FILTER")
+	if isIPFilter == "true" {
+		providerNames := os.Getenv("IP_LOOKUP_PROVIDERS")
+		if len(providerNames) == 0 {
+			providerNames = "dbip"
+		}
+		var providers []service.Provider
+		for _, name := range strings.Split(providerNames, ",") {
+			switch name = strings.TrimSpace(name); name {
+			case "dbip":
+				dbipToken := os.Getenv("DB_IP_TOKEN")
+				if len(dbipToken) == 0 {
+					logrus.Errorf("[IPFilter] IPFilter enabled, but DB_IP_TOKEN does not provided!!")
+					panic(errors.New("DB_IP_TOKEN is null"))
+				}
+				dbipConfig := &service.DBIPConfig{Token: dbipToken}
+				if t := os.Getenv("DB_IP_TIMEOUT"); len(t) != 0 {
+					dbipConfig.Timeout, err = time.ParseDuration(t)
+					if err != nil {
+						logrus.WithError(err).Fatalf("[IPFilter] Invalid DB_IP_TIMEOUT: %s", t)
+					}
+				}
+				if r := os.Getenv("DB_IP_RETRIES"); len(r) != 0 {
+					dbipConfig.Retries, err = strconv.Atoi(r)
+					if err != nil {
+						logrus.WithError(err).Fatalf("[IPFilter] Invalid DB_IP_RETRIES: %s", r)
+					}
+				}
+				providers = append(providers, service.NewDBIPProvider(dbipConfig))
+			case "mmdb":
+				paths := strings.Split(os.Getenv("MMDB_PATHS"), ",")
+				provider, err := service.NewMMDBProvider(paths...)
+				if err != nil {
+					logrus.WithError(err).Fatalf("[IPFilter] Failed open MMDB_PATHS")
+				}
+				providers = append(providers, provider)
+			case "static":
+				provider, err := service.LoadStaticProvider(os.Getenv("IP_LOOKUP_STATIC_FILE"))
+				if err != nil {
+					logrus.WithError(err).Fatalf("[IPFilter] Failed load IP_LOOKUP_STATIC_FILE")
+				}
+				providers = append(providers, provider)
+			default:
+				logrus.Fatalf("[IPFilter] Unknown IP lookup provider: %s", name)
+			}
+		}
+
+		var policies []service.IPPolicy
+		if p := os.Getenv("IP_FILTER_POLICIES"); len(p) != 0 {
+			policies, err = service.ParsePolicies([]byte(p))
+			if err != nil {
+				logrus.WithError(err).Fatalf("[IPFilter] Invalid IP_FILTER_POLICIES")
+			}
+		} else if f := os.Getenv("IP_FILTER_POLICY_FILE"); len(f) != 0 {
+			policies, err = service.LoadPolicies(f)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[IPFilter] Invalid IP_FILTER_POLICY_FILE: %s", f)
+			}
+		}
+
+		cache := &service.LookupCacheConfig{}
+		if t := os.Getenv("IP_LOOKUP_CACHE_TTL"); len(t) != 0 {
+			cache.TTL, err = time.ParseDuration(t)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[IPFilter] Invalid IP_LOOKUP_CACHE_TTL: %s", t)
+			}
+		}
+		if t := os.Getenv("IP_LOOKUP_NEGATIVE_TTL"); len(t) != 0 {
+			cache.NegativeTTL, err = time.ParseDuration(t)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[IPFilter] Invalid IP_LOOKUP_NEGATIVE_TTL: %s", t)
+			}
+		}
+		if n := os.Getenv("IP_LOOKUP_CACHE_SIZE"); len(n) != 0 {
+			cache.MaxSize, err = strconv.Atoi(n)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[IPFilter] Invalid IP_LOOKUP_CACHE_SIZE: %s", n)
+			}
+		}
+		switch store := os.Getenv("IP_LOOKUP_CACHE_STORE"); store {
+		case "", "memory":
+		case "redis":
+			redisAddr := os.Getenv("REDIS_ADDRESS")
+			if len(redisAddr) == 0 {
+				redisAddr = "localhost:6379"
+			}
+			cache.Store = service.NewRedisLookupStore(redisAddr)
+		case "mysql":
+			cache.Store = service.NewMysqlLookupStore(mysqlClient)
+		default:
+			logrus.Fatalf("[IPFilter] Unknown IP_LOOKUP_CACHE_STORE: %s", store)
+		}
+
+		failClosed := false
+		switch policy := os.Getenv("IP_LOOKUP_FAIL_POLICY"); policy {
+		case "", "open":
+		case "closed":
+			failClosed = true
+		default:
+			logrus.Fatalf("[IPFilter] Unknown IP_LOOKUP_FAIL_POLICY: %s", policy)
+		}
+
+		ipfw, err := service.NewIPFilter(mysqlClient, &service.IPFilterConfig{
+			Provider:   service.NewChainProvider(providers...),
+			Policies:   policies,
+			Cache:      cache,
+			FailClosed: failClosed,
+		})
+		if err != nil {
+			panic(err)
+		}
+		svc.IPFilter = ipfw
+	}
+
+	// IP Ban
+	ipBans, err := service.NewIPBanList(mysqlClient, 10*time.Second)
+	if err != nil {
+		logrus.WithError(err).Fatalf("[Punishment] Failed load IP bans")
+	}
+	svc.IPBans = ipBans
+
+	// Login Address (stored as HMAC when key is set)
+	svc.Addresses = service.NewAddressHasher(os.Getenv("PLAYER_ADDRESS_HMAC_KEY"))
+
+	// Connection Limit
+	if os.Getenv("ENABLE_CONNECTION_LIMIT") == "true" {
+		limits := &service.ConnectionLimitConfig{}
+		for _, l := range []struct {
+			env  string
+			def  string
+			rule *service.LimitRule
+		}{
+			{"CONNECTION_LIMIT_IP", "5/1m", &limits.PerIP},
+			{"CONNECTION_LIMIT_SUBNET", "20/1m", &limits.PerSubnet},
+			{"CONNECTION_LIMIT_GLOBAL", "200/10s", &limits.Global},
+		} {
+			v := os.Getenv(l.env)
+			if len(v) == 0 {
+				v = l.def
+			}
+			*l.rule, err = service.ParseLimitRule(v)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[Connection] Invalid %s: %s", l.env, v)
+			}
+		}
+		if d := os.Getenv("CONNECTION_BAN_DURATION"); len(d) != 0 {
+			limits.BanDuration, err = time.ParseDuration(d)
+			if err != nil {
+				logrus.WithError(err).Fatalf("[Connection] Invalid CONNECTION_BAN_DURATION: %s", d)
+			}
+		}
+
+		var counter service.RateCounter
+		switch store := os.Getenv("CONNECTION_LIMIT_STORE"); store {
+		case "", "memory":
+			counter = service.NewMemoryRateCounter()
+		case "redis":
+			redisAddr := os.Getenv("REDIS_ADDRESS")
+			if len(redisAddr) == 0 {
+				redisAddr = "localhost:6379"
+			}
+			counter = service.NewRedisRateCounter(redisAddr)
+		default:
+			logrus.Fatalf("[Connection] Unknown CONNECTION_LIMIT_STORE: %s", store)
+		}
+		svc.Limiter = service.NewConnectionLimiter(counter, limits)
+	}
+
+	// Outbox
+	outboxRetention := 24 * time.Hour
+	if r := os.Getenv("OUTBOX_RETENTION"); len(r) != 0 {
+		d, err := time.ParseDuration(r)
+		if err != nil {
+			logrus.WithError(err).Fatalf("[Outbox] Invalid OUTBOX_RETENTION: %s", r)
+		}
+		outboxRetention = d
+	}
+	svc.Outbox = &stream.OutboxConfig{
+		Interval:  200 * time.Millisecond,
+		Retention: outboxRetention,
+	}
+
 	// gRPC
 	wait := make(chan struct{})
 	go func() {
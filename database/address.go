@@ -0,0 +1,110 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PlayerAddresses - Login address per player (raw or hashed)
+type PlayerAddresses struct {
+	Id        int64  `gorm:"primaryKey;AutoIncrement;"`
+	UUID      string `gorm:"uniqueIndex:idx_player_address;size:36;not null;"`
+	Name      string
+	Address   string `gorm:"uniqueIndex:idx_player_address;index;size:64;not null;"`
+	Subnet    string `gorm:"index;size:64;"`
+	FirstSeen time.Time
+	LastSeen  time.Time `gorm:"index"`
+	Count     int64
+}
+
+func (a *PlayerAddresses) ToProtobuf() *nebulapb.PlayerAddress {
+	return &nebulapb.PlayerAddress{
+		PlayerUUID: a.UUID,
+		PlayerName: a.Name,
+		Address:    a.Address,
+		Subnet:     a.Subnet,
+		FirstSeen:  a.FirstSeen.Unix(),
+		LastSeen:   a.LastSeen.Unix(),
+		Count:      a.Count,
+	}
+}
+
+// RecordPlayerAddress - Add login address or update last seen
+func (s *Mysql) RecordPlayerAddress(uuid, name, address, subnet string) error {
+	now := time.Now()
+	r := s.client.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "uuid"}, {Name: "address"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"name":      name,
+			"subnet":    subnet,
+			"last_seen": now,
+			"count":     gorm.Expr("count + 1"),
+		}),
+	}).Create(&PlayerAddresses{
+		UUID:      uuid,
+		Name:      name,
+		Address:   address,
+		Subnet:    subnet,
+		FirstSeen: now,
+		LastSeen:  now,
+		Count:     1,
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed RecordPlayerAddress (%s)", uuid)
+		return r.Error
+	}
+
+	return nil
+}
+
+// GetPlayerAddresses - Get login addresses of player (newest first)
+func (s *Mysql) GetPlayerAddresses(uuid string, limit, offset int) ([]PlayerAddresses, error) {
+	var addresses []PlayerAddresses
+	r := s.client.Where("uuid = ?", uuid).Order("last_seen DESC").Limit(limit).Offset(offset).Find(&addresses)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find PlayerAddresses")
+		return nil, r.Error
+	}
+
+	return addresses, nil
+}
+
+// GetLatestPlayerAddresses - Get latest login address of each player
+func (s *Mysql) GetLatestPlayerAddresses(uuids []string) (map[string]PlayerAddresses, error) {
+	var addresses []PlayerAddresses
+	r := s.client.Where("uuid IN ?", uuids).Order("last_seen DESC").Find(&addresses)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find PlayerAddresses")
+		return nil, r.Error
+	}
+
+	latest := map[string]PlayerAddresses{}
+	for _, a := range addresses {
+		if _, ok := latest[a.UUID]; !ok {
+			latest[a.UUID] = a
+		}
+	}
+	return latest, nil
+}
+
+// GetAddressPlayers - Get players logged in from address (or subnet) (newest first)
+func (s *Mysql) GetAddressPlayers(address, subnet string, limit int) ([]PlayerAddresses, error) {
+	var addresses []PlayerAddresses
+	var q *gorm.DB
+	if len(subnet) != 0 {
+		q = s.client.Where("subnet = ?", subnet)
+	} else {
+		q = s.client.Where("address = ?", address)
+	}
+	r := q.Order("last_seen DESC").Limit(limit).Find(&addresses)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find PlayerAddresses")
+		return nil, r.Error
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,142 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
+)
+
+// Announcements - Scheduled broadcast definition
+type Announcements struct {
+	Id          int64  `gorm:"primaryKey;AutoIncrement;"`
+	Message     string `gorm:"type:text"`
+	TargetType  int32
+	TargetValue string
+	Title       string `gorm:"type:text"`
+	Actionbar   string `gorm:"type:text"`
+	Interval    int64
+	Enabled     bool
+	NextRunAt   time.Time `gorm:"index"`
+}
+
+func (a *Announcements) ToProtobuf() *nebulapb.Announcement {
+	return &nebulapb.Announcement{
+		Id:        a.Id,
+		Broadcast: a.BroadcastProtobuf(),
+		Interval:  a.Interval,
+		Enabled:   a.Enabled,
+		NextRunAt: a.NextRunAt.Unix(),
+	}
+}
+
+// BroadcastProtobuf - Build broadcast payload from announcement
+func (a *Announcements) BroadcastProtobuf() *nebulapb.Broadcast {
+	return &nebulapb.Broadcast{
+		Message: a.Message,
+		Target: &nebulapb.BroadcastTarget{
+			Type:  nebulapb.BroadcastTarget_Type(a.TargetType),
+			Value: a.TargetValue,
+		},
+		Title: func() *nebulapb.BroadcastTitle {
+			if len(a.Title) == 0 {
+				return nil
+			}
+			title := &nebulapb.BroadcastTitle{}
+			if err := json.Unmarshal([]byte(a.Title), title); err != nil {
+				return nil
+			}
+			return title
+		}(),
+		Actionbar: a.Actionbar,
+	}
+}
+
+func AnnouncementsFromProtobuf(p *nebulapb.Announcement) *Announcements {
+	a := &Announcements{
+		Id:       p.Id,
+		Interval: p.Interval,
+		Enabled:  p.Enabled,
+		NextRunAt: func() time.Time {
+			if p.NextRunAt == 0 {
+				return time.Now().Add(time.Duration(p.Interval) * time.Second)
+			}
+			return time.Unix(p.NextRunAt, 0)
+		}(),
+	}
+
+	if b := p.Broadcast; b != nil {
+		a.Message = b.Message
+		a.Actionbar = b.Actionbar
+		if b.Target != nil {
+			a.TargetType = int32(b.Target.Type)
+			a.TargetValue = b.Target.Value
+		}
+		if b.Title != nil {
+			r, _ := json.Marshal(b.Title)
+			a.Title = string(r)
+		}
+	}
+
+	return a
+}
+
+// GetAllAnnouncements - Get All Announcements
+func (s *Mysql) GetAllAnnouncements() ([]Announcements, error) {
+	var announcements []Announcements
+	r := s.client.Find(&announcements)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed Find Announcements")
+		return nil, r.Error
+	}
+
+	return announcements, nil
+}
+
+// GetDueAnnouncements - Get enabled announcements which should be published
+func (s *Mysql) GetDueAnnouncements(now time.Time) ([]Announcements, error) {
+	var announcements []Announcements
+	r := s.client.Where("enabled = ? AND next_run_at <= ?", true, now).Find(&announcements)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed Find due Announcements")
+		return nil, r.Error
+	}
+
+	return announcements, nil
+}
+
+// AddAnnouncement - Add Announcement
+func (s *Mysql) AddAnnouncement(data *Announcements) error {
+	r := s.client.Create(data)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed AddAnnouncement")
+		return r.Error
+	}
+
+	return nil
+}
+
+// RemoveAnnouncement - Remove Announcement
+func (s *Mysql) RemoveAnnouncement(id int64) error {
+	r := s.client.Delete(&Announcements{}, "id = ?", id)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Announcement] Failed RemoveAnnouncement")
+		return r.Error
+	}
+
+	return nil
+}
+
+// ClaimAnnouncement - Move next run time forward.
+// Returns false when another instance already claimed this run.
+func (s *Mysql) ClaimAnnouncement(data Announcements, now time.Time) (bool, error) {
+	r := s.client.Model(&Announcements{}).
+		Where("id = ? AND next_run_at = ?", data.Id, data.NextRunAt).
+		Update("next_run_at", now.Add(time.Duration(data.Interval)*time.Second))
+	if r.Error != nil {
+		return false, r.Error
+	}
+
+	return r.RowsAffected != 0, nil
+}
@@ -1,9 +1,13 @@
 package database
 
+import "gorm.io/gorm"
+
 type Bungee struct {
-	Id      string `gorm:"primaryKey;"`
-	Motd    string
-	Favicon string `gorm:"size:4096"`
+	Id        string `gorm:"primaryKey;"`
+	Motd      string
+	Favicon   string `gorm:"size:4096"`
+	Whitelist bool
+	Revision  int64
 }
 
 // InitBungeeTable - Initialize table (create default entry)
@@ -30,7 +34,10 @@ func (s *Mysql) GetBungeeEntry() (Bungee, error) {
 
 // SetMotd - Set Motd
 func (s *Mysql) SetMotd(motd string) error {
-	r := s.client.Model(&Bungee{}).Where("id = ?", "default").Update("motd", motd)
+	r := s.client.Model(&Bungee{}).Where("id = ?", "default").Updates(map[string]interface{}{
+		"motd":     motd,
+		"revision": gorm.Expr("revision + 1"),
+	})
 	if r.Error != nil {
 		return r.Error
 	}
@@ -40,7 +47,23 @@ func (s *Mysql) SetMotd(motd string) error {
 
 // SetFavicon - Set Favicon
 func (s *Mysql) SetFavicon(favicon string) error {
-	r := s.client.Model(&Bungee{}).Where("id = ?", "default").Update("favicon", favicon)
+	r := s.client.Model(&Bungee{}).Where("id = ?", "default").Updates(map[string]interface{}{
+		"favicon":  favicon,
+		"revision": gorm.Expr("revision + 1"),
+	})
+	if r.Error != nil {
+		return r.Error
+	}
+
+	return nil
+}
+
+// SetWhitelist - Enable / Disable network whitelist
+func (s *Mysql) SetWhitelist(enabled bool) error {
+	r := s.client.Model(&Bungee{}).Where("id = ?", "default").Updates(map[string]interface{}{
+		"whitelist": enabled,
+		"revision":  gorm.Expr("revision + 1"),
+	})
 	if r.Error != nil {
 		return r.Error
 	}
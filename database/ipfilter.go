@@ -2,15 +2,19 @@ package database
 
 import (
 	"errors"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
 )
 
 type IPFilter struct {
-	Id          string `gorm:"primaryKey;AutoIncrement;"`
+	Id          int64 `gorm:"primaryKey;AutoIncrement;"`
 	Action      FilterAction
 	Address     string `gorm:"index;not null;"`
 	Description string
+	// ExpiresAt - temporary entry (nil: permanent)
+	ExpiresAt *time.Time `gorm:"index"`
 }
 
 type FilterAction int32
@@ -20,12 +24,45 @@ const (
 	DENY
 )
 
+func (f *IPFilter) ToProtobuf() *nebulapb.IPFilterEntry {
+	entry := &nebulapb.IPFilterEntry{
+		Id:          f.Id,
+		Action:      nebulapb.IPFilterEntry_Action(f.Action),
+		Address:     f.Address,
+		Description: f.Description,
+	}
+	if f.ExpiresAt != nil {
+		entry.ExpiresAt = f.ExpiresAt.Unix()
+	}
+
+	return entry
+}
+
+func IPFilterFromProtobuf(p *nebulapb.IPFilterEntry) *IPFilter {
+	f := &IPFilter{
+		Id:          p.Id,
+		Action:      FilterAction(p.Action),
+		Address:     p.Address,
+		Description: p.Description,
+	}
+	if p.ExpiresAt != 0 {
+		t := time.Unix(p.ExpiresAt, 0)
+		f.ExpiresAt = &t
+	}
+
+	return f
+}
+
 func (s *Mysql) AddIPFilter(entry *IPFilter) error {
-	r := s.client.First(&IPFilter{}, "address = ?", entry.Address)
+	if _, err := s.PurgeIPFilter(time.Now()); err != nil {
+		return err
+	}
+
+	r := s.client.Limit(1).Find(&IPFilter{}, "address = ?", entry.Address)
 
 	if r.RowsAffected != 0 {
 		return errors.New("already exists")
-	} else if r.Error != nil && r.RowsAffected != 0 {
+	} else if r.Error != nil {
 		return r.Error
 	}
 
@@ -43,13 +80,30 @@ func (s *Mysql) RemoveIPFilter(address string) error {
 	return r.Error
 }
 
+// GetIPFilter - Get unexpired filter entry (nil when not found)
 func (s *Mysql) GetIPFilter(address string) (*IPFilter, error) {
-	var entry *IPFilter
-	r := s.client.Find(&entry, "address = ?", address)
-	return entry, r.Error
+	var entries []IPFilter
+	r := s.client.Where("address = ? AND (expires_at IS NULL OR expires_at > ?)", address, time.Now()).Limit(1).Find(&entries)
+	if r.Error != nil || len(entries) == 0 {
+		return nil, r.Error
+	}
+	return &entries[0], nil
 }
 
-// func (s *Mysql) ListIPFilter() ([]IPFilter, error) {
-//     var entries []IPFilter
-//
-// }
+// ListIPFilter - Get unexpired filter entries
+func (s *Mysql) ListIPFilter() ([]IPFilter, error) {
+	var entries []IPFilter
+	r := s.client.Where("expires_at IS NULL OR expires_at > ?", time.Now()).Order("id").Find(&entries)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[IPFW] Failed Find IPFilter")
+		return nil, r.Error
+	}
+
+	return entries, nil
+}
+
+// PurgeIPFilter - Delete entries expired before t
+func (s *Mysql) PurgeIPFilter(t time.Time) (int64, error) {
+	r := s.client.Where("expires_at IS NOT NULL AND expires_at <= ?", t).Delete(&IPFilter{})
+	return r.RowsAffected, r.Error
+}
@@ -0,0 +1,50 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+)
+
+// IPLookupCache - Cached external lookup result
+type IPLookupCache struct {
+	Address   string    `gorm:"primaryKey;size:64;"`
+	Result    string    `gorm:"type:text"`
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+// GetIPLookupCache - Get unexpired lookup result (empty when not cached)
+func (s *Mysql) GetIPLookupCache(address string) (string, error) {
+	var entries []IPLookupCache
+	r := s.client.Where("address = ? AND expires_at > ?", address, time.Now()).Limit(1).Find(&entries)
+	if r.Error != nil || len(entries) == 0 {
+		return "", r.Error
+	}
+
+	return entries[0].Result, nil
+}
+
+// SetIPLookupCache - Store lookup result
+func (s *Mysql) SetIPLookupCache(address, result string, expiresAt time.Time) error {
+	r := s.client.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"result", "expires_at"}),
+	}).Create(&IPLookupCache{
+		Address:   address,
+		Result:    result,
+		ExpiresAt: expiresAt,
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[IPFW] Failed SetIPLookupCache (%s)", address)
+		return r.Error
+	}
+
+	return nil
+}
+
+// PurgeIPLookupCache - Delete expired lookup results
+func (s *Mysql) PurgeIPLookupCache(now time.Time) (int64, error) {
+	r := s.client.Where("expires_at <= ?", now).Delete(&IPLookupCache{})
+	return r.RowsAffected, r.Error
+}
@@ -10,6 +10,8 @@ import (
 type Mysql struct {
 	client   *gorm.DB
 	database string
+	// inTx - client is a transaction (created by Transaction)
+	inTx bool
 }
 
 func NewMysqlClient(mysqlConStr, database string) *Mysql {
@@ -50,11 +52,81 @@ func NewMysqlClient(mysqlConStr, database string) *Mysql {
 		return nil
 	}
 
+	if err := m.client.AutoMigrate(&Announcements{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&Proxies{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&PlayerSessions{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&PlayerAddresses{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&Whitelist{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&Punishments{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&IPFilter{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&IPLookupCache{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&OutboxLease{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&OutboxSequence{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
+	if err := m.client.AutoMigrate(&Outbox{}); err != nil {
+		logrus.Fatalf("[MySQL] Failed to migrate: %s", err)
+		return nil
+	}
+
 	if err := m.InitBungeeTable(); err != nil {
 		return nil
 	}
 
+	if err := m.InitOutboxTable(); err != nil {
+		return nil
+	}
+
 	logrus.Infof("[MySQL] Connected to MySQL")
 
 	return m
 }
+
+// Transaction - Run fn in a transaction (rollback when fn returns error)
+func (s *Mysql) Transaction(fn func(tx *Mysql) error) error {
+	return s.client.Transaction(func(tx *gorm.DB) error {
+		return fn(&Mysql{
+			client:   tx,
+			database: s.database,
+			inTx:     true,
+		})
+	})
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Outbox - Stream message waiting for publish.
+// Written in the same transaction as the change it describes.
+type Outbox struct {
+	Id          uint64 `gorm:"primaryKey;AutoIncrement;"`
+	Channel     string `gorm:"not null;"`
+	MessageType string `gorm:"not null;"`
+	Payload     []byte `gorm:"type:blob"`
+	Attempts    int
+	LastError   string `gorm:"type:text"`
+	CreatedAt   time.Time
+	PublishedAt *time.Time `gorm:"index"`
+}
+
+// OutboxLease - Only the lease owner publishes outbox messages (keeps order across instances)
+type OutboxLease struct {
+	Id        string `gorm:"primaryKey;size:32;"`
+	Owner     string
+	ExpiresAt time.Time
+}
+
+// OutboxSequence - Locked by Enqueue until commit,
+// so outbox entries become visible in id order (readers never skip an uncommitted id).
+// Value is the latest enqueued id (kept after outbox is purged).
+type OutboxSequence struct {
+	Id    string `gorm:"primaryKey;size:32;"`
+	Value uint64
+}
+
+// InitOutboxTable - Initialize table (create lease and sequence entry)
+func (s *Mysql) InitOutboxTable() error {
+	if r := s.client.FirstOrCreate(&OutboxLease{Id: "default"}, "id = ?", "default"); r.Error != nil {
+		return r.Error
+	}
+	if r := s.client.FirstOrCreate(&OutboxSequence{Id: "default"}, "id = ?", "default"); r.Error != nil {
+		return r.Error
+	}
+
+	return nil
+}
+
+// Message - Decode payload into registered protobuf message.
+// sequence and timestamp fields are filled from outbox entry.
+func (o *Outbox) Message() (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(o.MessageType))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(o.Payload, msg); err != nil {
+		return nil, err
+	}
+
+	m := msg.ProtoReflect()
+	if f := m.Descriptor().Fields().ByName("sequence"); f != nil {
+		m.Set(f, protoreflect.ValueOfUint64(o.Id))
+	}
+	if f := m.Descriptor().Fields().ByName("timestamp"); f != nil {
+		m.Set(f, protoreflect.ValueOfInt64(o.CreatedAt.UnixNano()/int64(time.Millisecond)))
+	}
+
+	return msg, nil
+}
+
+// Enqueue - Add message to outbox (in own transaction when called outside of Transaction)
+func (s *Mysql) Enqueue(channel string, msg proto.Message) error {
+	if !s.inTx {
+		// sequence lock must be held until the message is committed
+		return s.Transaction(func(tx *Mysql) error {
+			return tx.Enqueue(channel, msg)
+		})
+	}
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	// serialize outbox writers: lock is held until surrounding transaction commits
+	var seq OutboxSequence
+	if r := s.client.Clauses(clause.Locking{Strength: "UPDATE"}).First(&seq, "id = ?", "default"); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Outbox] Failed lock sequence: %s", channel)
+		return r.Error
+	}
+
+	entry := &Outbox{
+		Channel:     channel,
+		MessageType: string(msg.ProtoReflect().Descriptor().FullName()),
+		Payload:     b,
+	}
+	if r := s.client.Create(entry); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Outbox] Failed Enqueue: %s", channel)
+		return r.Error
+	}
+
+	if r := s.client.Model(&OutboxSequence{}).Where("id = ?", "default").Update("value", entry.Id); r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Outbox] Failed update sequence: %s", channel)
+		return r.Error
+	}
+
+	return nil
+}
+
+// AcquireOutboxLease - Take or extend publisher lease.
+// Returns false while another instance holds the lease.
+func (s *Mysql) AcquireOutboxLease(owner string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	r := s.client.Model(&OutboxLease{}).
+		Where("id = ? AND (owner = ? OR expires_at < ?)", "default", owner, now).
+		Updates(map[string]interface{}{
+			"owner":      owner,
+			"expires_at": now.Add(ttl),
+		})
+	if r.Error != nil {
+		return false, r.Error
+	}
+
+	return r.RowsAffected != 0, nil
+}
+
+// GetPendingOutbox - Get unpublished messages in order (non-locking read, publish without holding transaction)
+func (s *Mysql) GetPendingOutbox(limit int) ([]Outbox, error) {
+	var entries []Outbox
+	r := s.client.
+		Where("published_at IS NULL").
+		Order("id").
+		Limit(limit).
+		Find(&entries)
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	return entries, nil
+}
+
+// GetOutboxSince - Get messages after sequence in order (published or not, all channels when channels is empty).
+// Enqueue commits in id order, so no lower id can appear after a higher one was read.
+func (s *Mysql) GetOutboxSince(sequence uint64, channels, exclude []string, limit int) ([]Outbox, error) {
+	var entries []Outbox
+	q := s.client.Where("id > ?", sequence)
+	if len(channels) != 0 {
+		q = q.Where("channel IN ?", channels)
+	}
+	if len(exclude) != 0 {
+		q = q.Where("channel NOT IN ?", exclude)
+	}
+	r := q.Order("id").Limit(limit).Find(&entries)
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	return entries, nil
+}
+
+// GetOutboxBounds - Get oldest sequence still in outbox and latest enqueued sequence.
+// Oldest is latest + 1 when every message was purged.
+func (s *Mysql) GetOutboxBounds() (uint64, uint64, error) {
+	var bounds struct {
+		Oldest uint64
+		Latest uint64
+	}
+	r := s.client.Model(&Outbox{}).Select("COALESCE(MIN(id), 0) AS oldest, COALESCE(MAX(id), 0) AS latest").Scan(&bounds)
+	if r.Error != nil {
+		return 0, 0, r.Error
+	}
+
+	var seq OutboxSequence
+	if r := s.client.Find(&seq, "id = ?", "default"); r.Error != nil {
+		return 0, 0, r.Error
+	}
+	if seq.Value > bounds.Latest {
+		bounds.Latest = seq.Value
+	}
+	if bounds.Oldest == 0 {
+		bounds.Oldest = bounds.Latest + 1
+	}
+
+	return bounds.Oldest, bounds.Latest, nil
+}
+
+// MarkOutboxPublished - Mark message as published
+func (s *Mysql) MarkOutboxPublished(id uint64) error {
+	r := s.client.Model(&Outbox{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"published_at": time.Now(),
+		"attempts":     gorm.Expr("attempts + 1"),
+	})
+	return r.Error
+}
+
+// MarkOutboxFailed - Record failed publish attempt
+func (s *Mysql) MarkOutboxFailed(id uint64, publishErr error) error {
+	r := s.client.Model(&Outbox{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"last_error": publishErr.Error(),
+		"attempts":   gorm.Expr("attempts + 1"),
+	})
+	return r.Error
+}
+
+// PurgeOutbox - Delete messages published before t
+func (s *Mysql) PurgeOutbox(t time.Time) (int64, error) {
+	r := s.client.Where("published_at IS NOT NULL AND published_at < ?", t).Delete(&Outbox{})
+	return r.RowsAffected, r.Error
+}
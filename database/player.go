@@ -2,9 +2,13 @@ package database
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/nebula-api/nebulapb"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -13,8 +17,12 @@ type Players struct {
 	UUID          string `gorm:"index;unique;"`
 	Name          string `gorm:"index;not null;"`
 	CurrentServer string
+	ProxyID       string `gorm:"index;"`
 	Latency       int64
 	RawProperties string `gorm:"type:text"`
+	Revision      int64
+	Hide          bool
+	UpdatedAt     time.Time
 }
 
 type UpdateOption struct {
@@ -34,6 +42,7 @@ func (p *Players) ToProtobuf() *nebulapb.PlayerProfile {
 			}
 			return properties
 		}(),
+		Hide: p.Hide,
 	}
 }
 
@@ -50,12 +59,128 @@ func PlayersFromProtobuf(p *nebulapb.PlayerProfile) *Players {
 				return string(b)
 			}
 		}(),
+		Hide: p.Hide,
 	}
 }
 
-func (s *Mysql) GetAllPlayers() ([]Players, error) {
+// upsertPlayers - Insert or update players (revision will be incremented).
+// hide is kept on update, since it's changed by SetPlayerHidden only.
+func upsertPlayers() clause.OnConflict {
+	return clause.OnConflict{
+		Columns: []clause.Column{{Name: "uuid"}},
+		DoUpdates: append(
+			clause.AssignmentColumns([]string{"name", "current_server", "proxy_id", "latency", "raw_properties", "updated_at"}),
+			clause.Assignment{Column: clause.Column{Name: "revision"}, Value: gorm.Expr("revision + 1")},
+		),
+	}
+}
+
+// GetPlayer - Get player by UUID
+func (s *Mysql) GetPlayer(uuid string) (Players, error) {
+	player := Players{}
+	r := s.client.First(&player, "uuid = ?", uuid)
+	if r.Error != nil {
+		return Players{}, r.Error
+	}
+
+	return player, nil
+}
+
+// GetPlayerByName - Get player by name (case-insensitive).
+// Name may be left on renamed player, so online / recently updated player is preferred.
+func (s *Mysql) GetPlayerByName(name string) (Players, error) {
 	var players []Players
-	r := s.client.Where("current_server != ?", "").Find(&players)
+	r := s.client.Where("name = ?", name).Order("updated_at DESC").Find(&players)
+	if r.Error != nil {
+		return Players{}, r.Error
+	}
+
+	var found *Players
+	for i, p := range players {
+		// column collation may be case-sensitive
+		if !strings.EqualFold(p.Name, name) {
+			continue
+		}
+		if found == nil || (found.CurrentServer == "" && p.CurrentServer != "") {
+			found = &players[i]
+		}
+	}
+	if found == nil {
+		r := s.client.Where("LOWER(name) = LOWER(?)", name).Order("updated_at DESC").Limit(1).Find(&players)
+		if r.Error != nil {
+			return Players{}, r.Error
+		} else if len(players) == 0 {
+			return Players{}, gorm.ErrRecordNotFound
+		}
+		found = &players[0]
+	}
+
+	return *found, nil
+}
+
+// GetPlayers - Get players by UUIDs
+func (s *Mysql) GetPlayers(uuids []string) ([]Players, error) {
+	var players []Players
+	r := s.client.Where("uuid IN ?", uuids).Find(&players)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find Player")
+		return nil, r.Error
+	}
+
+	return players, nil
+}
+
+// GetPlayersOnProxy - Get online players logged in via proxy
+func (s *Mysql) GetPlayersOnProxy(proxyID string) ([]Players, error) {
+	var players []Players
+	r := s.client.Where("proxy_id = ? AND current_server != ?", proxyID, "").Find(&players)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find Player")
+		return nil, r.Error
+	}
+
+	return players, nil
+}
+
+// SetPlayerOffline - Mark player as offline
+func (s *Mysql) SetPlayerOffline(uuid string) error {
+	r := s.client.Model(&Players{}).Where("uuid = ?", uuid).Updates(map[string]interface{}{
+		"current_server": "",
+		"proxy_id":       "",
+		"revision":       gorm.Expr("revision + 1"),
+	})
+	return r.Error
+}
+
+// GetPlayersOnServer - Get online players on server (ordered by name)
+func (s *Mysql) GetPlayersOnServer(server string, includeHidden bool, limit, offset int) ([]Players, int64, error) {
+	q := s.client.Model(&Players{}).Where("current_server = ?", server)
+	if !includeHidden {
+		q = q.Where("hide = ?", false)
+	}
+
+	var total int64
+	if r := q.Count(&total); r.Error != nil {
+		return nil, 0, r.Error
+	}
+
+	var players []Players
+	r := q.Order("name").Limit(limit).Offset(offset).Find(&players)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] Failed Find Player")
+		return nil, 0, r.Error
+	}
+
+	return players, total, nil
+}
+
+func (s *Mysql) GetAllPlayers(includeHidden bool) ([]Players, error) {
+	var players []Players
+	q := s.client.Where("current_server != ?", "")
+	if !includeHidden {
+		q = q.Where("hide = ?", false)
+	}
+	r := q.Find(&players)
 	if r.Error != nil {
 		logrus.WithError(r.Error).Errorf("[Player] Failed Find Player")
 		return nil, r.Error
@@ -65,17 +190,49 @@ func (s *Mysql) GetAllPlayers() ([]Players, error) {
 }
 
 func (s *Mysql) UpdateAllPlayers(players []Players) error {
-	r := s.client.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "uuid"}},
-		UpdateAll: true,
-	}).Create(players)
+	for i := range players {
+		players[i].Revision = 1
+	}
+	r := s.client.Clauses(upsertPlayers()).Create(players)
 
 	return r.Error
 }
 
+// SetPlayerHidden - Set player hidden (vanish)
+func (s *Mysql) SetPlayerHidden(uuid string, hidden bool) error {
+	r := s.client.Model(&Players{}).Where("uuid = ?", uuid).Updates(map[string]interface{}{
+		"hide":     hidden,
+		"revision": gorm.Expr("revision + 1"),
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] SetPlayerHidden: Failed update player data (%s)", uuid)
+		return r.Error
+	} else if r.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// SwitchPlayerServer - Move online player to server
+func (s *Mysql) SwitchPlayerServer(uuid, server string) error {
+	r := s.client.Model(&Players{}).Where("uuid = ? AND current_server != ?", uuid, "").Updates(map[string]interface{}{
+		"current_server": server,
+		"revision":       gorm.Expr("revision + 1"),
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Player] SwitchPlayerServer: Failed update player data (%s)", uuid)
+		return r.Error
+	} else if r.RowsAffected == 0 {
+		return errors.New("player is not online")
+	}
+
+	return nil
+}
+
 func (s *Mysql) SyncPlayer(newPlayer *Players, opts *UpdateOption) error {
 	var player Players
-	findRes := s.client.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&Players{}).First(&player, "uuid = ?", newPlayer.UUID)
+	findRes := s.client.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&Players{}).Limit(1).Find(&player, "uuid = ?", newPlayer.UUID)
 	if findRes.Error != nil {
 		logrus.WithError(findRes.Error).Errorf("[Player] SyncPlayer: Failed update player data (%s)", newPlayer.UUID)
 		return findRes.Error
@@ -87,10 +244,8 @@ func (s *Mysql) SyncPlayer(newPlayer *Players, opts *UpdateOption) error {
 		player.CurrentServer = newPlayer.CurrentServer
 	}
 
-	r := s.client.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "uuid"}},
-		UpdateAll: true,
-	}).Create(newPlayer)
+	newPlayer.Revision = 1
+	r := s.client.Clauses(upsertPlayers()).Create(newPlayer)
 
 	return r.Error
 }
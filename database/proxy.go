@@ -0,0 +1,54 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
+)
+
+// Proxies - Proxy liveness
+type Proxies struct {
+	Id       string    `gorm:"primaryKey;size:191;"`
+	LastSeen time.Time `gorm:"index"`
+}
+
+// ProxyHeartbeat - Update proxy last seen
+func (s *Mysql) ProxyHeartbeat(id string) error {
+	r := s.client.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_seen"}),
+	}).Create(&Proxies{
+		Id:       id,
+		LastSeen: time.Now(),
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Proxy] Failed ProxyHeartbeat (%s)", id)
+		return r.Error
+	}
+
+	return nil
+}
+
+// GetStaleProxies - Get proxies which didn't send heartbeat since before
+func (s *Mysql) GetStaleProxies(before time.Time) ([]Proxies, error) {
+	var proxies []Proxies
+	r := s.client.Where("last_seen < ?", before).Find(&proxies)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Proxy] Failed Find Proxies")
+		return nil, r.Error
+	}
+
+	return proxies, nil
+}
+
+// RemoveStaleProxy - Remove proxy if still stale.
+// Returns false when heartbeat arrived or another instance already removed it.
+func (s *Mysql) RemoveStaleProxy(id string, before time.Time) (bool, error) {
+	r := s.client.Delete(&Proxies{}, "id = ? AND last_seen < ?", id, before)
+	if r.Error != nil {
+		return false, r.Error
+	}
+
+	return r.RowsAffected != 0, nil
+}
@@ -0,0 +1,159 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
+)
+
+// Punishments - Ban / Mute / Warn history
+type Punishments struct {
+	Id           int64  `gorm:"primaryKey;AutoIncrement;"`
+	Type         int32  `gorm:"index"`
+	UUID         string `gorm:"index;size:36;"`
+	Name         string
+	Address      string `gorm:"index;size:64;"`
+	Reason       string `gorm:"type:text"`
+	ActorUUID    string
+	ActorName    string
+	CreatedAt    time.Time
+	ExpiresAt    *time.Time `gorm:"index"`
+	Revoked      bool
+	RevokedAt    *time.Time
+	RevokedBy    string
+	RevokeReason string `gorm:"type:text"`
+}
+
+const activePunishment = "revoked = ? AND (expires_at IS NULL OR expires_at > ?)"
+
+// IsActive - not revoked and not expired
+func (p *Punishments) IsActive() bool {
+	return !p.Revoked && (p.ExpiresAt == nil || p.ExpiresAt.After(time.Now()))
+}
+
+func (p *Punishments) ToProtobuf() *nebulapb.Punishment {
+	punishment := &nebulapb.Punishment{
+		Id:           p.Id,
+		Type:         nebulapb.Punishment_Type(p.Type),
+		PlayerUUID:   p.UUID,
+		PlayerName:   p.Name,
+		Address:      p.Address,
+		Reason:       p.Reason,
+		ActorUUID:    p.ActorUUID,
+		ActorName:    p.ActorName,
+		CreatedAt:    p.CreatedAt.Unix(),
+		Revoked:      p.Revoked,
+		RevokedBy:    p.RevokedBy,
+		RevokeReason: p.RevokeReason,
+		Active:       p.IsActive(),
+	}
+	if p.ExpiresAt != nil {
+		punishment.ExpiresAt = p.ExpiresAt.Unix()
+	}
+	if p.RevokedAt != nil {
+		punishment.RevokedAt = p.RevokedAt.Unix()
+	}
+
+	return punishment
+}
+
+func PunishmentsFromProtobuf(p *nebulapb.Punishment) *Punishments {
+	punishment := &Punishments{
+		Id:        p.Id,
+		Type:      int32(p.Type),
+		UUID:      p.PlayerUUID,
+		Name:      p.PlayerName,
+		Address:   p.Address,
+		Reason:    p.Reason,
+		ActorUUID: p.ActorUUID,
+		ActorName: p.ActorName,
+	}
+	if p.ExpiresAt != 0 {
+		t := time.Unix(p.ExpiresAt, 0)
+		punishment.ExpiresAt = &t
+	}
+
+	return punishment
+}
+
+// AddPunishment - Add Punishment
+func (s *Mysql) AddPunishment(data *Punishments) error {
+	r := s.client.Create(data)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punishment] Failed AddPunishment")
+		return r.Error
+	}
+
+	return nil
+}
+
+// GetPunishment - Get Punishment (ErrRecordNotFound when not exists)
+func (s *Mysql) GetPunishment(id int64) (Punishments, error) {
+	var punishment Punishments
+	r := s.client.First(&punishment, "id = ?", id)
+	return punishment, r.Error
+}
+
+// RevokePunishment - Revoke Punishment.
+// Returns false when already revoked.
+func (s *Mysql) RevokePunishment(id int64, by, reason string) (bool, error) {
+	r := s.client.Model(&Punishments{}).Where("id = ? AND revoked = ?", id, false).Updates(map[string]interface{}{
+		"revoked":       true,
+		"revoked_at":    time.Now(),
+		"revoked_by":    by,
+		"revoke_reason": reason,
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punishment] Failed RevokePunishment (%d)", id)
+		return false, r.Error
+	}
+
+	return r.RowsAffected != 0, nil
+}
+
+// GetPunishments - Get punishments of player or address (newest first)
+func (s *Mysql) GetPunishments(uuid, address string, includeInactive bool, limit, offset int) ([]Punishments, error) {
+	var punishments []Punishments
+	q := s.client
+	if len(uuid) != 0 {
+		q = q.Where("uuid = ?", uuid)
+	}
+	if len(address) != 0 {
+		q = q.Where("address = ?", address)
+	}
+	if !includeInactive {
+		q = q.Where(activePunishment, false, time.Now())
+	}
+	r := q.Order("id DESC").Limit(limit).Offset(offset).Find(&punishments)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punishment] Failed Find Punishments")
+		return nil, r.Error
+	}
+
+	return punishments, nil
+}
+
+// GetActivePunishments - Get active punishments of player by type
+func (s *Mysql) GetActivePunishments(uuid string, types ...nebulapb.Punishment_Type) ([]Punishments, error) {
+	var punishments []Punishments
+	r := s.client.Where("uuid = ? AND type IN ?", uuid, types).Where(activePunishment, false, time.Now()).Order("id DESC").Find(&punishments)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punishment] Failed Find Punishments")
+		return nil, r.Error
+	}
+
+	return punishments, nil
+}
+
+// GetActiveIPBans - Get all active IP bans
+func (s *Mysql) GetActiveIPBans() ([]Punishments, error) {
+	var punishments []Punishments
+	r := s.client.Where("type = ?", int32(nebulapb.Punishment_IPBAN)).Where(activePunishment, false, time.Now()).Order("id DESC").Find(&punishments)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Punishment] Failed Find Punishments")
+		return nil, r.Error
+	}
+
+	return punishments, nil
+}
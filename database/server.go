@@ -1,9 +1,11 @@
 package database
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 // Servers - Server definition
@@ -15,9 +17,13 @@ type Servers struct {
 	Port        int32
 	Motd        string
 	Fallback    bool
+	Group       string `gorm:"index"`
 	//Lockdown    *Lockdown `gorm:"references:Lockdown"`
 	Lockdown string `gorm:"type:json;"`
 	Status   string `gorm:"type:json;"`
+	Revision int64
+	// DeletedAt - removed entry is kept as tombstone, revision continues when re-added
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 // Lockdown - Server lockdown entry
@@ -76,14 +82,39 @@ func (s *Mysql) GetServerEntry(name string) (Servers, error) {
 	return server, nil
 }
 
-// AddServerEntry - Add Server Entry
-func (s *Mysql) AddServerEntry(data Servers) error {
-	r := s.client.First(&Servers{}, "name = ?", data.Name)
+// AddServerEntry - Add Server Entry (returns revision)
+func (s *Mysql) AddServerEntry(data Servers) (int64, error) {
+	existing := Servers{}
+	r := s.client.Unscoped().Where("name = ?", data.Name).Limit(1).Find(&existing)
+	if r.Error != nil {
+		return 0, r.Error
+	}
+
+	if r.RowsAffected != 0 && !existing.DeletedAt.Valid {
+		return 0, errors.New("already exists")
+	}
 
 	if r.RowsAffected != 0 {
-		return errors.New("already exists")
-	} else if r.Error != nil && r.RowsAffected != 0 {
-		return r.Error
+		// re-add removed server: keep revision monotonic
+		revision := existing.Revision + 1
+		result := s.client.Unscoped().Model(&Servers{}).Where("id = ?", existing.Id).Updates(map[string]interface{}{
+			"display_name": data.DisplayName,
+			"address":      data.Address,
+			"port":         data.Port,
+			"motd":         data.Motd,
+			"fallback":     data.Fallback,
+			"group":        data.Group,
+			"lockdown":     data.Lockdown,
+			"status":       "{}",
+			"revision":     revision,
+			"deleted_at":   nil,
+		})
+		if result.Error != nil {
+			logrus.WithError(result.Error).Errorf("[Server] Failed AddServerEntry")
+			return 0, result.Error
+		}
+
+		return revision, nil
 	}
 
 	result := s.client.Create(&Servers{
@@ -93,21 +124,29 @@ func (s *Mysql) AddServerEntry(data Servers) error {
 		Port:        data.Port,
 		Motd:        data.Motd,
 		Fallback:    data.Fallback,
+		Group:       data.Group,
 		Lockdown:    data.Lockdown,
 		Status:      "{}",
+		Revision:    1,
 	})
 
 	if result.Error != nil {
 		logrus.WithError(result.Error).Errorf("[Server] Failed AddServerEntry")
-		return result.Error
+		return 0, result.Error
 	}
 
-	return nil
+	return 1, nil
 }
 
-// RemoveServerEntry - RemoveServerEntry
+// RemoveServerEntry - RemoveServerEntry (bumps revision of tombstone)
 func (s *Mysql) RemoveServerEntry(name string) error {
-	r := s.client.Delete(&Servers{}, "name = ?", name)
+	r := s.client.Model(&Servers{}).Where("name = ?", name).Update("revision", gorm.Expr("revision + 1"))
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Server] Failed RemoveServerEntry")
+		return r.Error
+	}
+
+	r = s.client.Delete(&Servers{}, "name = ?", name)
 	if r.Error != nil {
 		logrus.WithError(r.Error).Errorf("[Server] Failed RemoveServerEntry")
 		return r.Error
@@ -118,7 +157,10 @@ func (s *Mysql) RemoveServerEntry(name string) error {
 
 // PushServerStatus - Push Server Status
 func (s *Mysql) PushServerStatus(name, response string) (string, int, error) {
-	r := s.client.Model(&Servers{}).Where("name = ?", name).Update("status", response)
+	r := s.client.Model(&Servers{}).Where("name = ?", name).Updates(map[string]interface{}{
+		"status":   response,
+		"revision": gorm.Expr("revision + 1"),
+	})
 
 	if r.Error != nil {
 		return "", 0, r.Error
@@ -129,10 +171,14 @@ func (s *Mysql) PushServerStatus(name, response string) (string, int, error) {
 
 // SetLockdown - Set server Lockdown
 func (s *Mysql) SetLockdown(name string, enabled bool, description string) error {
-	r := s.client.Model(&Servers{}).Where("name = ?", name).Update("lockdown", Lockdown{
+	lockdown, _ := json.Marshal(Lockdown{
 		Enabled:     enabled,
 		Description: description,
 	})
+	r := s.client.Model(&Servers{}).Where("name = ?", name).Updates(map[string]interface{}{
+		"lockdown": string(lockdown),
+		"revision": gorm.Expr("revision + 1"),
+	})
 
 	return r.Error
 }
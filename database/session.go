@@ -0,0 +1,147 @@
+package database
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
+)
+
+// PlayerSessions - Player stay on a server (login / switch -> switch / logout)
+type PlayerSessions struct {
+	Id        int64  `gorm:"primaryKey;AutoIncrement;"`
+	UUID      string `gorm:"index;not null;"`
+	Name      string
+	Server    string
+	StartedAt time.Time `gorm:"index"`
+	EndedAt   *time.Time
+	Duration  int64
+	Login     bool
+	Logout    bool
+}
+
+// PlayerPlaytime - Aggregated playtime
+type PlayerPlaytime struct {
+	Total     int64
+	Period    int64
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Online    bool
+}
+
+func (p *PlayerSessions) ToProtobuf() *nebulapb.PlayerSession {
+	session := &nebulapb.PlayerSession{
+		Id:         p.Id,
+		PlayerUUID: p.UUID,
+		PlayerName: p.Name,
+		Server:     p.Server,
+		StartedAt:  p.StartedAt.Unix(),
+		Duration:   p.Duration,
+		Login:      p.Login,
+		Logout:     p.Logout,
+	}
+	if p.EndedAt != nil {
+		session.EndedAt = p.EndedAt.Unix()
+	} else {
+		session.Duration = int64(time.Since(p.StartedAt) / time.Second)
+	}
+
+	return session
+}
+
+// OpenPlayerSession - Start new session (open session will be closed as server switch)
+func (s *Mysql) OpenPlayerSession(uuid, name, server string, login bool) error {
+	if err := s.ClosePlayerSession(uuid, false); err != nil {
+		return err
+	}
+
+	r := s.client.Create(&PlayerSessions{
+		UUID:      uuid,
+		Name:      name,
+		Server:    server,
+		StartedAt: time.Now(),
+		Login:     login,
+	})
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed OpenPlayerSession (%s)", uuid)
+		return r.Error
+	}
+
+	return nil
+}
+
+// ClosePlayerSession - End open session
+func (s *Mysql) ClosePlayerSession(uuid string, logout bool) error {
+	return s.ClosePlayerSessionAt(uuid, logout, time.Now())
+}
+
+// ClosePlayerSessionAt - End open session at specified time
+func (s *Mysql) ClosePlayerSessionAt(uuid string, logout bool, at time.Time) error {
+	r := s.client.Exec(
+		"UPDATE player_sessions SET ended_at = GREATEST(started_at, ?), duration = GREATEST(0, TIMESTAMPDIFF(SECOND, started_at, ?)), logout = ? WHERE uuid = ? AND ended_at IS NULL",
+		at, at, logout, uuid,
+	)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed ClosePlayerSession (%s)", uuid)
+		return r.Error
+	}
+
+	return nil
+}
+
+// GetPlayerSessions - Get sessions (newest first)
+func (s *Mysql) GetPlayerSessions(uuid string, limit, offset int) ([]PlayerSessions, error) {
+	var sessions []PlayerSessions
+	r := s.client.Where("uuid = ?", uuid).Order("started_at DESC, id DESC").Limit(limit).Offset(offset).Find(&sessions)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed Find PlayerSessions")
+		return nil, r.Error
+	}
+
+	return sessions, nil
+}
+
+// GetPlayerPlaytime - Get total playtime and playtime between since and until
+func (s *Mysql) GetPlayerPlaytime(uuid string, since, until time.Time) (PlayerPlaytime, error) {
+	now := time.Now()
+	var result struct {
+		Total     int64
+		Period    int64
+		FirstSeen *time.Time
+		LastSeen  *time.Time
+		Online    int64
+	}
+
+	r := s.client.Raw(`SELECT
+		COALESCE(SUM(TIMESTAMPDIFF(SECOND, started_at, COALESCE(ended_at, @now))), 0) AS total,
+		COALESCE(SUM(GREATEST(0, TIMESTAMPDIFF(SECOND, GREATEST(started_at, @since), LEAST(COALESCE(ended_at, @now), @until)))), 0) AS period,
+		MIN(started_at) AS first_seen,
+		MAX(COALESCE(ended_at, @now)) AS last_seen,
+		COALESCE(SUM(ended_at IS NULL), 0) AS online
+		FROM player_sessions WHERE uuid = @uuid`,
+		map[string]interface{}{
+			"now":   now,
+			"since": since,
+			"until": until,
+			"uuid":  uuid,
+		},
+	).Scan(&result)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Session] Failed GetPlayerPlaytime (%s)", uuid)
+		return PlayerPlaytime{}, r.Error
+	}
+
+	playtime := PlayerPlaytime{
+		Total:  result.Total,
+		Period: result.Period,
+		Online: result.Online > 0,
+	}
+	if result.FirstSeen != nil {
+		playtime.FirstSeen = *result.FirstSeen
+	}
+	if result.LastSeen != nil {
+		playtime.LastSeen = *result.LastSeen
+	}
+
+	return playtime, nil
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/nebulapb"
+)
+
+// Whitelist - Network whitelist entry (UUID or name)
+type Whitelist struct {
+	Id        int64  `gorm:"primaryKey;AutoIncrement;"`
+	UUID      string `gorm:"index;size:36;"`
+	Name      string `gorm:"index;size:16;"`
+	AddedBy   string
+	CreatedAt time.Time
+}
+
+func (w *Whitelist) ToProtobuf() *nebulapb.WhitelistEntry {
+	return &nebulapb.WhitelistEntry{
+		PlayerUUID: w.UUID,
+		PlayerName: w.Name,
+		AddedBy:    w.AddedBy,
+		CreatedAt:  w.CreatedAt.Unix(),
+	}
+}
+
+// findWhitelist - Match by UUID, then by name (case-insensitive)
+func (s *Mysql) findWhitelist(uuid, name string) ([]Whitelist, error) {
+	var entries []Whitelist
+	q := s.client.Where("uuid = ?", uuid)
+	if len(uuid) == 0 {
+		q = s.client.Where("LOWER(name) = ?", strings.ToLower(name))
+	} else if len(name) != 0 {
+		q = q.Or("uuid = ? AND LOWER(name) = ?", "", strings.ToLower(name))
+	}
+	r := q.Find(&entries)
+	return entries, r.Error
+}
+
+// IsWhitelisted - Check player is whitelisted
+func (s *Mysql) IsWhitelisted(uuid, name string) (bool, error) {
+	entries, err := s.findWhitelist(uuid, name)
+	if err != nil {
+		return false, err
+	}
+	return len(entries) != 0, nil
+}
+
+// AddWhitelist - Add whitelist entry
+func (s *Mysql) AddWhitelist(entry *Whitelist) error {
+	entries, err := s.findWhitelist(entry.UUID, entry.Name)
+	if err != nil {
+		return err
+	} else if len(entries) != 0 {
+		return errors.New("already exists")
+	}
+
+	r := s.client.Create(entry)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Whitelist] Failed AddWhitelist")
+		return r.Error
+	}
+
+	return nil
+}
+
+// RemoveWhitelist - Remove whitelist entries of player
+func (s *Mysql) RemoveWhitelist(uuid, name string) (int64, error) {
+	entries, err := s.findWhitelist(uuid, name)
+	if err != nil || len(entries) == 0 {
+		return 0, err
+	}
+
+	r := s.client.Delete(&entries)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Whitelist] Failed RemoveWhitelist")
+		return 0, r.Error
+	}
+
+	return r.RowsAffected, nil
+}
+
+// GetWhitelist - Get all whitelist entries
+func (s *Mysql) GetWhitelist() ([]Whitelist, error) {
+	var entries []Whitelist
+	r := s.client.Order("id").Find(&entries)
+	if r.Error != nil {
+		logrus.WithError(r.Error).Errorf("[Whitelist] Failed Find Whitelist")
+		return nil, r.Error
+	}
+
+	return entries, nil
+}
@@ -26,6 +26,8 @@ const (
 	PlayerPropertiesStream_JOIN_SOLO     PlayerPropertiesStream_Type = 0
 	PlayerPropertiesStream_QUIT_SOLO     PlayerPropertiesStream_Type = 1
 	PlayerPropertiesStream_ADVERTISE_ALL PlayerPropertiesStream_Type = 2
+	PlayerPropertiesStream_SWITCH_SOLO   PlayerPropertiesStream_Type = 3
+	PlayerPropertiesStream_UPDATE_SOLO   PlayerPropertiesStream_Type = 4
 )
 
 // Enum value maps for PlayerPropertiesStream_Type.
@@ -34,11 +36,15 @@ var (
 		0: "JOIN_SOLO",
 		1: "QUIT_SOLO",
 		2: "ADVERTISE_ALL",
+		3: "SWITCH_SOLO",
+		4: "UPDATE_SOLO",
 	}
 	PlayerPropertiesStream_Type_value = map[string]int32{
 		"JOIN_SOLO":     0,
 		"QUIT_SOLO":     1,
 		"ADVERTISE_ALL": 2,
+		"SWITCH_SOLO":   3,
+		"UPDATE_SOLO":   4,
 	}
 )
 
@@ -66,7 +72,102 @@ func (x PlayerPropertiesStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlayerPropertiesStream_Type.Descriptor instead.
 func (PlayerPropertiesStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_nebulapb_proto_rawDescGZIP(), []int{0, 0}
+	return file_nebulapb_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type ProxyStream_Type int32
+
+const (
+	ProxyStream_MESSAGE  ProxyStream_Type = 0
+	ProxyStream_TRANSFER ProxyStream_Type = 1
+	ProxyStream_KICK     ProxyStream_Type = 2
+)
+
+// Enum value maps for ProxyStream_Type.
+var (
+	ProxyStream_Type_name = map[int32]string{
+		0: "MESSAGE",
+		1: "TRANSFER",
+		2: "KICK",
+	}
+	ProxyStream_Type_value = map[string]int32{
+		"MESSAGE":  0,
+		"TRANSFER": 1,
+		"KICK":     2,
+	}
+)
+
+func (x ProxyStream_Type) Enum() *ProxyStream_Type {
+	p := new(ProxyStream_Type)
+	*p = x
+	return p
+}
+
+func (x ProxyStream_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProxyStream_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_nebulapb_proto_enumTypes[1].Descriptor()
+}
+
+func (ProxyStream_Type) Type() protoreflect.EnumType {
+	return &file_nebulapb_proto_enumTypes[1]
+}
+
+func (x ProxyStream_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProxyStream_Type.Descriptor instead.
+func (ProxyStream_Type) EnumDescriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type PunishmentStream_Type int32
+
+const (
+	PunishmentStream_PUNISH PunishmentStream_Type = 0
+	PunishmentStream_REVOKE PunishmentStream_Type = 1
+)
+
+// Enum value maps for PunishmentStream_Type.
+var (
+	PunishmentStream_Type_name = map[int32]string{
+		0: "PUNISH",
+		1: "REVOKE",
+	}
+	PunishmentStream_Type_value = map[string]int32{
+		"PUNISH": 0,
+		"REVOKE": 1,
+	}
+)
+
+func (x PunishmentStream_Type) Enum() *PunishmentStream_Type {
+	p := new(PunishmentStream_Type)
+	*p = x
+	return p
+}
+
+func (x PunishmentStream_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PunishmentStream_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_nebulapb_proto_enumTypes[2].Descriptor()
+}
+
+func (PunishmentStream_Type) Type() protoreflect.EnumType {
+	return &file_nebulapb_proto_enumTypes[2]
+}
+
+func (x PunishmentStream_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PunishmentStream_Type.Descriptor instead.
+func (PunishmentStream_Type) EnumDescriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type ServerEntryStream_Type int32
@@ -99,11 +200,11 @@ func (x ServerEntryStream_Type) String() string {
 }
 
 func (ServerEntryStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_nebulapb_proto_enumTypes[1].Descriptor()
+	return file_nebulapb_proto_enumTypes[3].Descriptor()
 }
 
 func (ServerEntryStream_Type) Type() protoreflect.EnumType {
-	return &file_nebulapb_proto_enumTypes[1]
+	return &file_nebulapb_proto_enumTypes[3]
 }
 
 func (x ServerEntryStream_Type) Number() protoreflect.EnumNumber {
@@ -112,7 +213,7 @@ func (x ServerEntryStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServerEntryStream_Type.Descriptor instead.
 func (ServerEntryStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_nebulapb_proto_rawDescGZIP(), []int{1, 0}
+	return file_nebulapb_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type BungeeEntryStream_Type int32
@@ -145,11 +246,11 @@ func (x BungeeEntryStream_Type) String() string {
 }
 
 func (BungeeEntryStream_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_nebulapb_proto_enumTypes[2].Descriptor()
+	return file_nebulapb_proto_enumTypes[4].Descriptor()
 }
 
 func (BungeeEntryStream_Type) Type() protoreflect.EnumType {
-	return &file_nebulapb_proto_enumTypes[2]
+	return &file_nebulapb_proto_enumTypes[4]
 }
 
 func (x BungeeEntryStream_Type) Number() protoreflect.EnumNumber {
@@ -158,22 +259,181 @@ func (x BungeeEntryStream_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BungeeEntryStream_Type.Descriptor instead.
 func (BungeeEntryStream_Type) EnumDescriptor() ([]byte, []int) {
-	return file_nebulapb_proto_rawDescGZIP(), []int{11, 0}
+	return file_nebulapb_proto_rawDescGZIP(), []int{16, 0}
 }
 
-// PlayerPropertiesStream
-type PlayerPropertiesStream struct {
+type IPFilterEntry_Action int32
+
+const (
+	IPFilterEntry_ALLOW IPFilterEntry_Action = 0
+	IPFilterEntry_DENY  IPFilterEntry_Action = 1
+)
+
+// Enum value maps for IPFilterEntry_Action.
+var (
+	IPFilterEntry_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+	}
+	IPFilterEntry_Action_value = map[string]int32{
+		"ALLOW": 0,
+		"DENY":  1,
+	}
+)
+
+func (x IPFilterEntry_Action) Enum() *IPFilterEntry_Action {
+	p := new(IPFilterEntry_Action)
+	*p = x
+	return p
+}
+
+func (x IPFilterEntry_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPFilterEntry_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_nebulapb_proto_enumTypes[5].Descriptor()
+}
+
+func (IPFilterEntry_Action) Type() protoreflect.EnumType {
+	return &file_nebulapb_proto_enumTypes[5]
+}
+
+func (x IPFilterEntry_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPFilterEntry_Action.Descriptor instead.
+func (IPFilterEntry_Action) EnumDescriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{40, 0}
+}
+
+type BroadcastTarget_Type int32
+
+const (
+	BroadcastTarget_ALL        BroadcastTarget_Type = 0
+	BroadcastTarget_SERVER     BroadcastTarget_Type = 1
+	BroadcastTarget_GROUP      BroadcastTarget_Type = 2
+	BroadcastTarget_PERMISSION BroadcastTarget_Type = 3
+)
+
+// Enum value maps for BroadcastTarget_Type.
+var (
+	BroadcastTarget_Type_name = map[int32]string{
+		0: "ALL",
+		1: "SERVER",
+		2: "GROUP",
+		3: "PERMISSION",
+	}
+	BroadcastTarget_Type_value = map[string]int32{
+		"ALL":        0,
+		"SERVER":     1,
+		"GROUP":      2,
+		"PERMISSION": 3,
+	}
+)
+
+func (x BroadcastTarget_Type) Enum() *BroadcastTarget_Type {
+	p := new(BroadcastTarget_Type)
+	*p = x
+	return p
+}
+
+func (x BroadcastTarget_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BroadcastTarget_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_nebulapb_proto_enumTypes[6].Descriptor()
+}
+
+func (BroadcastTarget_Type) Type() protoreflect.EnumType {
+	return &file_nebulapb_proto_enumTypes[6]
+}
+
+func (x BroadcastTarget_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BroadcastTarget_Type.Descriptor instead.
+func (BroadcastTarget_Type) EnumDescriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{96, 0}
+}
+
+type Punishment_Type int32
+
+const (
+	Punishment_BAN     Punishment_Type = 0
+	Punishment_TEMPBAN Punishment_Type = 1
+	Punishment_MUTE    Punishment_Type = 2
+	Punishment_WARN    Punishment_Type = 3
+	Punishment_IPBAN   Punishment_Type = 4
+)
+
+// Enum value maps for Punishment_Type.
+var (
+	Punishment_Type_name = map[int32]string{
+		0: "BAN",
+		1: "TEMPBAN",
+		2: "MUTE",
+		3: "WARN",
+		4: "IPBAN",
+	}
+	Punishment_Type_value = map[string]int32{
+		"BAN":     0,
+		"TEMPBAN": 1,
+		"MUTE":    2,
+		"WARN":    3,
+		"IPBAN":   4,
+	}
+)
+
+func (x Punishment_Type) Enum() *Punishment_Type {
+	p := new(Punishment_Type)
+	*p = x
+	return p
+}
+
+func (x Punishment_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Punishment_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_nebulapb_proto_enumTypes[7].Descriptor()
+}
+
+func (Punishment_Type) Type() protoreflect.EnumType {
+	return &file_nebulapb_proto_enumTypes[7]
+}
+
+func (x Punishment_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Punishment_Type.Descriptor instead.
+func (Punishment_Type) EnumDescriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{108, 0}
+}
+
+type StreamEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type PlayerPropertiesStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.PlayerPropertiesStream_Type" json:"type,omitempty"`
-	Solo *PlayerProfile              `protobuf:"bytes,2,opt,name=solo,proto3" json:"solo,omitempty"`
-	All  []*PlayerProfile            `protobuf:"bytes,3,rep,name=all,proto3" json:"all,omitempty"`
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Channel  string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Types that are assignable to Message:
+	//	*StreamEvent_Server
+	//	*StreamEvent_Bungee
+	//	*StreamEvent_Player
+	//	*StreamEvent_Broadcast
+	//	*StreamEvent_Proxy
+	//	*StreamEvent_Punishment
+	Message isStreamEvent_Message `protobuf_oneof:"message"`
 }
 
-func (x *PlayerPropertiesStream) Reset() {
-	*x = PlayerPropertiesStream{}
+func (x *StreamEvent) Reset() {
+	*x = StreamEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -181,13 +441,13 @@ func (x *PlayerPropertiesStream) Reset() {
 	}
 }
 
-func (x *PlayerPropertiesStream) String() string {
+func (x *StreamEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerPropertiesStream) ProtoMessage() {}
+func (*StreamEvent) ProtoMessage() {}
 
-func (x *PlayerPropertiesStream) ProtoReflect() protoreflect.Message {
+func (x *StreamEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,44 +459,130 @@ func (x *PlayerPropertiesStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerPropertiesStream.ProtoReflect.Descriptor instead.
-func (*PlayerPropertiesStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamEvent.ProtoReflect.Descriptor instead.
+func (*StreamEvent) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *PlayerPropertiesStream) GetType() PlayerPropertiesStream_Type {
+func (x *StreamEvent) GetSequence() uint64 {
 	if x != nil {
-		return x.Type
+		return x.Sequence
 	}
-	return PlayerPropertiesStream_JOIN_SOLO
+	return 0
 }
 
-func (x *PlayerPropertiesStream) GetSolo() *PlayerProfile {
+func (x *StreamEvent) GetChannel() string {
 	if x != nil {
-		return x.Solo
+		return x.Channel
+	}
+	return ""
+}
+
+func (m *StreamEvent) GetMessage() isStreamEvent_Message {
+	if m != nil {
+		return m.Message
 	}
 	return nil
 }
 
-func (x *PlayerPropertiesStream) GetAll() []*PlayerProfile {
-	if x != nil {
-		return x.All
+func (x *StreamEvent) GetServer() *ServerEntryStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Server); ok {
+		return x.Server
 	}
 	return nil
 }
 
-// ServerEntryStream (type: sync, remove)
-type ServerEntryStream struct {
+func (x *StreamEvent) GetBungee() *BungeeEntryStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Bungee); ok {
+		return x.Bungee
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetPlayer() *PlayerPropertiesStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Player); ok {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetBroadcast() *BroadcastStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Broadcast); ok {
+		return x.Broadcast
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetProxy() *ProxyStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Proxy); ok {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *StreamEvent) GetPunishment() *PunishmentStream {
+	if x, ok := x.GetMessage().(*StreamEvent_Punishment); ok {
+		return x.Punishment
+	}
+	return nil
+}
+
+type isStreamEvent_Message interface {
+	isStreamEvent_Message()
+}
+
+type StreamEvent_Server struct {
+	Server *ServerEntryStream `protobuf:"bytes,3,opt,name=server,proto3,oneof"`
+}
+
+type StreamEvent_Bungee struct {
+	Bungee *BungeeEntryStream `protobuf:"bytes,4,opt,name=bungee,proto3,oneof"`
+}
+
+type StreamEvent_Player struct {
+	Player *PlayerPropertiesStream `protobuf:"bytes,5,opt,name=player,proto3,oneof"`
+}
+
+type StreamEvent_Broadcast struct {
+	Broadcast *BroadcastStream `protobuf:"bytes,6,opt,name=broadcast,proto3,oneof"`
+}
+
+type StreamEvent_Proxy struct {
+	Proxy *ProxyStream `protobuf:"bytes,7,opt,name=proxy,proto3,oneof"`
+}
+
+type StreamEvent_Punishment struct {
+	Punishment *PunishmentStream `protobuf:"bytes,8,opt,name=punishment,proto3,oneof"`
+}
+
+func (*StreamEvent_Server) isStreamEvent_Message() {}
+
+func (*StreamEvent_Bungee) isStreamEvent_Message() {}
+
+func (*StreamEvent_Player) isStreamEvent_Message() {}
+
+func (*StreamEvent_Broadcast) isStreamEvent_Message() {}
+
+func (*StreamEvent_Proxy) isStreamEvent_Message() {}
+
+func (*StreamEvent_Punishment) isStreamEvent_Message() {}
+
+type GetChangesSinceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  ServerEntryStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.ServerEntryStream_Type" json:"type,omitempty"`
-	Entry *ServerEntry           `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// default: 100, max: 1000
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// filter by channel (default: all channels)
+	Channels []string `protobuf:"bytes,3,rep,name=channels,proto3" json:"channels,omitempty"`
+	// include nebula.player.staff instead of nebula.player.global (for staff)
+	IncludeHidden bool `protobuf:"varint,4,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
 }
 
-func (x *ServerEntryStream) Reset() {
-	*x = ServerEntryStream{}
+func (x *GetChangesSinceRequest) Reset() {
+	*x = GetChangesSinceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +590,13 @@ func (x *ServerEntryStream) Reset() {
 	}
 }
 
-func (x *ServerEntryStream) String() string {
+func (x *GetChangesSinceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerEntryStream) ProtoMessage() {}
+func (*GetChangesSinceRequest) ProtoMessage() {}
 
-func (x *ServerEntryStream) ProtoReflect() protoreflect.Message {
+func (x *GetChangesSinceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,42 +608,52 @@ func (x *ServerEntryStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerEntryStream.ProtoReflect.Descriptor instead.
-func (*ServerEntryStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChangesSinceRequest.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ServerEntryStream) GetType() ServerEntryStream_Type {
+func (x *GetChangesSinceRequest) GetSequence() uint64 {
 	if x != nil {
-		return x.Type
+		return x.Sequence
 	}
-	return ServerEntryStream_SYNC
+	return 0
 }
 
-func (x *ServerEntryStream) GetEntry() *ServerEntry {
+func (x *GetChangesSinceRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Entry
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetChangesSinceRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
 	}
 	return nil
 }
 
-type ServerEntry struct {
+func (x *GetChangesSinceRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetChangesSinceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DisplayName string        `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	Address     string        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Port        int32         `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	Motd        string        `protobuf:"bytes,5,opt,name=motd,proto3" json:"motd,omitempty"`
-	Fallback    bool          `protobuf:"varint,6,opt,name=fallback,proto3" json:"fallback,omitempty"`
-	Lockdown    *Lockdown     `protobuf:"bytes,7,opt,name=lockdown,proto3" json:"lockdown,omitempty"`
-	Status      *ServerStatus `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Events []*StreamEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// messages after requested sequence are already purged (resync required)
+	Truncated      bool   `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	LatestSequence uint64 `protobuf:"varint,3,opt,name=latestSequence,proto3" json:"latestSequence,omitempty"`
 }
 
-func (x *ServerEntry) Reset() {
-	*x = ServerEntry{}
+func (x *GetChangesSinceResponse) Reset() {
+	*x = GetChangesSinceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,13 +661,13 @@ func (x *ServerEntry) Reset() {
 	}
 }
 
-func (x *ServerEntry) String() string {
+func (x *GetChangesSinceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerEntry) ProtoMessage() {}
+func (*GetChangesSinceResponse) ProtoMessage() {}
 
-func (x *ServerEntry) ProtoReflect() protoreflect.Message {
+func (x *GetChangesSinceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,78 +679,53 @@ func (x *ServerEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerEntry.ProtoReflect.Descriptor instead.
-func (*ServerEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChangesSinceResponse.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ServerEntry) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ServerEntry) GetDisplayName() string {
+func (x *GetChangesSinceResponse) GetEvents() []*StreamEvent {
 	if x != nil {
-		return x.DisplayName
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *ServerEntry) GetAddress() string {
+func (x *GetChangesSinceResponse) GetTruncated() bool {
 	if x != nil {
-		return x.Address
+		return x.Truncated
 	}
-	return ""
+	return false
 }
 
-func (x *ServerEntry) GetPort() int32 {
+func (x *GetChangesSinceResponse) GetLatestSequence() uint64 {
 	if x != nil {
-		return x.Port
+		return x.LatestSequence
 	}
 	return 0
 }
 
-func (x *ServerEntry) GetMotd() string {
-	if x != nil {
-		return x.Motd
-	}
-	return ""
-}
-
-func (x *ServerEntry) GetFallback() bool {
-	if x != nil {
-		return x.Fallback
-	}
-	return false
-}
-
-func (x *ServerEntry) GetLockdown() *Lockdown {
-	if x != nil {
-		return x.Lockdown
-	}
-	return nil
-}
-
-func (x *ServerEntry) GetStatus() *ServerStatus {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-type Lockdown struct {
+// PlayerPropertiesStream
+// nebula.player.global: hidden players are excluded
+// nebula.player.staff: includes hidden players
+type PlayerPropertiesStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled     bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type     PlayerPropertiesStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.PlayerPropertiesStream_Type" json:"type,omitempty"`
+	Solo     *PlayerProfile              `protobuf:"bytes,2,opt,name=solo,proto3" json:"solo,omitempty"`
+	All      []*PlayerProfile            `protobuf:"bytes,3,rep,name=all,proto3" json:"all,omitempty"`
+	Sequence uint64                      `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// not set for ADVERTISE_ALL
+	Revision  int64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// SWITCH_SOLO: previous server (solo.currentServer is new server)
+	FromServer string `protobuf:"bytes,7,opt,name=fromServer,proto3" json:"fromServer,omitempty"`
 }
 
-func (x *Lockdown) Reset() {
-	*x = Lockdown{}
+func (x *PlayerPropertiesStream) Reset() {
+	*x = PlayerPropertiesStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,13 +733,13 @@ func (x *Lockdown) Reset() {
 	}
 }
 
-func (x *Lockdown) String() string {
+func (x *PlayerPropertiesStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Lockdown) ProtoMessage() {}
+func (*PlayerPropertiesStream) ProtoMessage() {}
 
-func (x *Lockdown) ProtoReflect() protoreflect.Message {
+func (x *PlayerPropertiesStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -420,40 +751,84 @@ func (x *Lockdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Lockdown.ProtoReflect.Descriptor instead.
-func (*Lockdown) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerPropertiesStream.ProtoReflect.Descriptor instead.
+func (*PlayerPropertiesStream) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Lockdown) GetEnabled() bool {
+func (x *PlayerPropertiesStream) GetType() PlayerPropertiesStream_Type {
 	if x != nil {
-		return x.Enabled
+		return x.Type
 	}
-	return false
+	return PlayerPropertiesStream_JOIN_SOLO
 }
 
-func (x *Lockdown) GetDescription() string {
+func (x *PlayerPropertiesStream) GetSolo() *PlayerProfile {
 	if x != nil {
-		return x.Description
+		return x.Solo
+	}
+	return nil
+}
+
+func (x *PlayerPropertiesStream) GetAll() []*PlayerProfile {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *PlayerPropertiesStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PlayerPropertiesStream) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PlayerPropertiesStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PlayerPropertiesStream) GetFromServer() string {
+	if x != nil {
+		return x.FromServer
 	}
 	return ""
 }
 
-type ServerStatus struct {
+// ProxyStream
+// nebula.proxy.<proxyId>: messages for players on the proxy
+// nebula.proxy.global: player's proxy is unknown (check player is on the proxy)
+type ProxyStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Online  bool                  `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
-	Version *ServerStatus_Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Players *ServerStatus_Players `protobuf:"bytes,3,opt,name=players,proto3" json:"players,omitempty"`
-	// i don't have any idea... (need map)
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Favicon     string `protobuf:"bytes,5,opt,name=favicon,proto3" json:"favicon,omitempty"`
-}
-
-func (x *ServerStatus) Reset() {
-	*x = ServerStatus{}
+	Type       ProxyStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.ProxyStream_Type" json:"type,omitempty"`
+	PlayerUUID string           `protobuf:"bytes,2,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	// MESSAGE: chat component (json)
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SenderUUID string `protobuf:"bytes,4,opt,name=senderUUID,proto3" json:"senderUUID,omitempty"`
+	SenderName string `protobuf:"bytes,5,opt,name=senderName,proto3" json:"senderName,omitempty"`
+	Sequence   uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp  int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// TRANSFER: connect player to the server
+	TargetServer string `protobuf:"bytes,8,opt,name=targetServer,proto3" json:"targetServer,omitempty"`
+	// KICK: disconnect player (message: reason)
+	Punishment *Punishment `protobuf:"bytes,9,opt,name=punishment,proto3" json:"punishment,omitempty"`
+}
+
+func (x *ProxyStream) Reset() {
+	*x = ProxyStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -461,13 +836,13 @@ func (x *ServerStatus) Reset() {
 	}
 }
 
-func (x *ServerStatus) String() string {
+func (x *ProxyStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatus) ProtoMessage() {}
+func (*ProxyStream) ProtoMessage() {}
 
-func (x *ServerStatus) ProtoReflect() protoreflect.Message {
+func (x *ProxyStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -479,55 +854,89 @@ func (x *ServerStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerStatus.ProtoReflect.Descriptor instead.
-func (*ServerStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProxyStream.ProtoReflect.Descriptor instead.
+func (*ProxyStream) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ServerStatus) GetOnline() bool {
+func (x *ProxyStream) GetType() ProxyStream_Type {
 	if x != nil {
-		return x.Online
+		return x.Type
 	}
-	return false
+	return ProxyStream_MESSAGE
 }
 
-func (x *ServerStatus) GetVersion() *ServerStatus_Version {
+func (x *ProxyStream) GetPlayerUUID() string {
 	if x != nil {
-		return x.Version
+		return x.PlayerUUID
 	}
-	return nil
+	return ""
 }
 
-func (x *ServerStatus) GetPlayers() *ServerStatus_Players {
+func (x *ProxyStream) GetMessage() string {
 	if x != nil {
-		return x.Players
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ServerStatus) GetDescription() string {
+func (x *ProxyStream) GetSenderUUID() string {
 	if x != nil {
-		return x.Description
+		return x.SenderUUID
 	}
 	return ""
 }
 
-func (x *ServerStatus) GetFavicon() string {
+func (x *ProxyStream) GetSenderName() string {
 	if x != nil {
-		return x.Favicon
+		return x.SenderName
 	}
 	return ""
 }
 
-// ServerEntry
-type GetServerEntryRequest struct {
+func (x *ProxyStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ProxyStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProxyStream) GetTargetServer() string {
+	if x != nil {
+		return x.TargetServer
+	}
+	return ""
+}
+
+func (x *ProxyStream) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+// PunishmentStream
+// nebula.punishment.global: punished / revoked (e.g. servers enforce mute)
+type PunishmentStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type       PunishmentStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.PunishmentStream_Type" json:"type,omitempty"`
+	Punishment *Punishment           `protobuf:"bytes,2,opt,name=punishment,proto3" json:"punishment,omitempty"`
+	Sequence   uint64                `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp  int64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetServerEntryRequest) Reset() {
-	*x = GetServerEntryRequest{}
+func (x *PunishmentStream) Reset() {
+	*x = PunishmentStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,13 +944,13 @@ func (x *GetServerEntryRequest) Reset() {
 	}
 }
 
-func (x *GetServerEntryRequest) String() string {
+func (x *PunishmentStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerEntryRequest) ProtoMessage() {}
+func (*PunishmentStream) ProtoMessage() {}
 
-func (x *GetServerEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *PunishmentStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,21 +962,54 @@ func (x *GetServerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetServerEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PunishmentStream.ProtoReflect.Descriptor instead.
+func (*PunishmentStream) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{5}
 }
 
-type GetServerEntryResponse struct {
+func (x *PunishmentStream) GetType() PunishmentStream_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentStream_PUNISH
+}
+
+func (x *PunishmentStream) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+func (x *PunishmentStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PunishmentStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// ServerEntryStream (type: sync, remove)
+type ServerEntryStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry []*ServerEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
+	Type      ServerEntryStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.ServerEntryStream_Type" json:"type,omitempty"`
+	Entry     *ServerEntry           `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Sequence  uint64                 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Revision  int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *GetServerEntryResponse) Reset() {
-	*x = GetServerEntryResponse{}
+func (x *ServerEntryStream) Reset() {
+	*x = ServerEntryStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,13 +1017,13 @@ func (x *GetServerEntryResponse) Reset() {
 	}
 }
 
-func (x *GetServerEntryResponse) String() string {
+func (x *ServerEntryStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerEntryResponse) ProtoMessage() {}
+func (*ServerEntryStream) ProtoMessage() {}
 
-func (x *GetServerEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerEntryStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,28 +1035,64 @@ func (x *GetServerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetServerEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerEntryStream.ProtoReflect.Descriptor instead.
+func (*ServerEntryStream) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetServerEntryResponse) GetEntry() []*ServerEntry {
+func (x *ServerEntryStream) GetType() ServerEntryStream_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ServerEntryStream_SYNC
+}
+
+func (x *ServerEntryStream) GetEntry() *ServerEntry {
 	if x != nil {
 		return x.Entry
 	}
 	return nil
 }
 
-type AddServerEntryRequest struct {
+func (x *ServerEntryStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ServerEntryStream) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ServerEntryStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ServerEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string        `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	Address     string        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Port        int32         `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Motd        string        `protobuf:"bytes,5,opt,name=motd,proto3" json:"motd,omitempty"`
+	Fallback    bool          `protobuf:"varint,6,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Lockdown    *Lockdown     `protobuf:"bytes,7,opt,name=lockdown,proto3" json:"lockdown,omitempty"`
+	Status      *ServerStatus `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Group       string        `protobuf:"bytes,9,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *AddServerEntryRequest) Reset() {
-	*x = AddServerEntryRequest{}
+func (x *ServerEntry) Reset() {
+	*x = ServerEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,13 +1100,13 @@ func (x *AddServerEntryRequest) Reset() {
 	}
 }
 
-func (x *AddServerEntryRequest) String() string {
+func (x *ServerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServerEntryRequest) ProtoMessage() {}
+func (*ServerEntry) ProtoMessage() {}
 
-func (x *AddServerEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,40 +1118,99 @@ func (x *AddServerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServerEntryRequest.ProtoReflect.Descriptor instead.
-func (*AddServerEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerEntry.ProtoReflect.Descriptor instead.
+func (*ServerEntry) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AddServerEntryRequest) GetEntry() *ServerEntry {
+func (x *ServerEntry) GetName() string {
 	if x != nil {
-		return x.Entry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type AddServerEntryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ServerEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (x *AddServerEntryResponse) Reset() {
-	*x = AddServerEntryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nebulapb_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ServerEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
+	return ""
 }
 
-func (x *AddServerEntryResponse) String() string {
+func (x *ServerEntry) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServerEntry) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+func (x *ServerEntry) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
+func (x *ServerEntry) GetLockdown() *Lockdown {
+	if x != nil {
+		return x.Lockdown
+	}
+	return nil
+}
+
+func (x *ServerEntry) GetStatus() *ServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ServerEntry) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type Lockdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled     bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Lockdown) Reset() {
+	*x = Lockdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lockdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServerEntryResponse) ProtoMessage() {}
+func (*Lockdown) ProtoMessage() {}
 
-func (x *AddServerEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *Lockdown) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,21 +1222,40 @@ func (x *AddServerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServerEntryResponse.ProtoReflect.Descriptor instead.
-func (*AddServerEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Lockdown.ProtoReflect.Descriptor instead.
+func (*Lockdown) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{8}
 }
 
-type RemoveServerEntryRequest struct {
+func (x *Lockdown) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Lockdown) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ServerStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Online  bool                  `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	Version *ServerStatus_Version `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Players *ServerStatus_Players `protobuf:"bytes,3,opt,name=players,proto3" json:"players,omitempty"`
+	// i don't have any idea... (need map)
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Favicon     string `protobuf:"bytes,5,opt,name=favicon,proto3" json:"favicon,omitempty"`
 }
 
-func (x *RemoveServerEntryRequest) Reset() {
-	*x = RemoveServerEntryRequest{}
+func (x *ServerStatus) Reset() {
+	*x = ServerStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +1263,13 @@ func (x *RemoveServerEntryRequest) Reset() {
 	}
 }
 
-func (x *RemoveServerEntryRequest) String() string {
+func (x *ServerStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServerEntryRequest) ProtoMessage() {}
+func (*ServerStatus) ProtoMessage() {}
 
-func (x *RemoveServerEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,26 +1281,55 @@ func (x *RemoveServerEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServerEntryRequest.ProtoReflect.Descriptor instead.
-func (*RemoveServerEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerStatus.ProtoReflect.Descriptor instead.
+func (*ServerStatus) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RemoveServerEntryRequest) GetName() string {
+func (x *ServerStatus) GetOnline() bool {
 	if x != nil {
-		return x.Name
+		return x.Online
+	}
+	return false
+}
+
+func (x *ServerStatus) GetVersion() *ServerStatus_Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *ServerStatus) GetPlayers() *ServerStatus_Players {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ServerStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-type RemoveServerEntryResponse struct {
+func (x *ServerStatus) GetFavicon() string {
+	if x != nil {
+		return x.Favicon
+	}
+	return ""
+}
+
+// ServerEntry
+type GetServerEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveServerEntryResponse) Reset() {
-	*x = RemoveServerEntryResponse{}
+func (x *GetServerEntryRequest) Reset() {
+	*x = GetServerEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,13 +1337,13 @@ func (x *RemoveServerEntryResponse) Reset() {
 	}
 }
 
-func (x *RemoveServerEntryResponse) String() string {
+func (x *GetServerEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServerEntryResponse) ProtoMessage() {}
+func (*GetServerEntryRequest) ProtoMessage() {}
 
-func (x *RemoveServerEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetServerEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -770,26 +1355,21 @@ func (x *RemoveServerEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServerEntryResponse.ProtoReflect.Descriptor instead.
-func (*RemoveServerEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServerEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetServerEntryRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{10}
 }
 
-// --
-// Bungee Entry
-// --
-type BungeeEntryStream struct {
+type GetServerEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    BungeeEntryStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.BungeeEntryStream_Type" json:"type,omitempty"`
-	Entry   *BungeeEntry           `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
-	Command string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Entry []*ServerEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *BungeeEntryStream) Reset() {
-	*x = BungeeEntryStream{}
+func (x *GetServerEntryResponse) Reset() {
+	*x = GetServerEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,13 +1377,13 @@ func (x *BungeeEntryStream) Reset() {
 	}
 }
 
-func (x *BungeeEntryStream) String() string {
+func (x *GetServerEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BungeeEntryStream) ProtoMessage() {}
+func (*GetServerEntryResponse) ProtoMessage() {}
 
-func (x *BungeeEntryStream) ProtoReflect() protoreflect.Message {
+func (x *GetServerEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,43 +1395,28 @@ func (x *BungeeEntryStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BungeeEntryStream.ProtoReflect.Descriptor instead.
-func (*BungeeEntryStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServerEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetServerEntryResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BungeeEntryStream) GetType() BungeeEntryStream_Type {
-	if x != nil {
-		return x.Type
-	}
-	return BungeeEntryStream_SYNC
-}
-
-func (x *BungeeEntryStream) GetEntry() *BungeeEntry {
+func (x *GetServerEntryResponse) GetEntry() []*ServerEntry {
 	if x != nil {
 		return x.Entry
 	}
 	return nil
 }
 
-func (x *BungeeEntryStream) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
-}
-
-type BungeeEntry struct {
+type AddServerEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Motd    string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
-	Favicon string `protobuf:"bytes,2,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *BungeeEntry) Reset() {
-	*x = BungeeEntry{}
+func (x *AddServerEntryRequest) Reset() {
+	*x = AddServerEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,13 +1424,13 @@ func (x *BungeeEntry) Reset() {
 	}
 }
 
-func (x *BungeeEntry) String() string {
+func (x *AddServerEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BungeeEntry) ProtoMessage() {}
+func (*AddServerEntryRequest) ProtoMessage() {}
 
-func (x *BungeeEntry) ProtoReflect() protoreflect.Message {
+func (x *AddServerEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -877,33 +1442,26 @@ func (x *BungeeEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BungeeEntry.ProtoReflect.Descriptor instead.
-func (*BungeeEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddServerEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddServerEntryRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BungeeEntry) GetMotd() string {
-	if x != nil {
-		return x.Motd
-	}
-	return ""
-}
-
-func (x *BungeeEntry) GetFavicon() string {
+func (x *AddServerEntryRequest) GetEntry() *ServerEntry {
 	if x != nil {
-		return x.Favicon
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type GetBungeeEntryRequest struct {
+type AddServerEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetBungeeEntryRequest) Reset() {
-	*x = GetBungeeEntryRequest{}
+func (x *AddServerEntryResponse) Reset() {
+	*x = AddServerEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,13 +1469,13 @@ func (x *GetBungeeEntryRequest) Reset() {
 	}
 }
 
-func (x *GetBungeeEntryRequest) String() string {
+func (x *AddServerEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBungeeEntryRequest) ProtoMessage() {}
+func (*AddServerEntryResponse) ProtoMessage() {}
 
-func (x *GetBungeeEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *AddServerEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -929,21 +1487,21 @@ func (x *GetBungeeEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBungeeEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetBungeeEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddServerEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddServerEntryResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{13}
 }
 
-type GetBungeeEntryResponse struct {
+type RemoveServerEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *BungeeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetBungeeEntryResponse) Reset() {
-	*x = GetBungeeEntryResponse{}
+func (x *RemoveServerEntryRequest) Reset() {
+	*x = RemoveServerEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -951,13 +1509,13 @@ func (x *GetBungeeEntryResponse) Reset() {
 	}
 }
 
-func (x *GetBungeeEntryResponse) String() string {
+func (x *RemoveServerEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBungeeEntryResponse) ProtoMessage() {}
+func (*RemoveServerEntryRequest) ProtoMessage() {}
 
-func (x *GetBungeeEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveServerEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -969,28 +1527,26 @@ func (x *GetBungeeEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBungeeEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetBungeeEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServerEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveServerEntryRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetBungeeEntryResponse) GetEntry() *BungeeEntry {
+func (x *RemoveServerEntryRequest) GetName() string {
 	if x != nil {
-		return x.Entry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type SendBungeeCommandRequest struct {
+type RemoveServerEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
 }
 
-func (x *SendBungeeCommandRequest) Reset() {
-	*x = SendBungeeCommandRequest{}
+func (x *RemoveServerEntryResponse) Reset() {
+	*x = RemoveServerEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,13 +1554,13 @@ func (x *SendBungeeCommandRequest) Reset() {
 	}
 }
 
-func (x *SendBungeeCommandRequest) String() string {
+func (x *RemoveServerEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBungeeCommandRequest) ProtoMessage() {}
+func (*RemoveServerEntryResponse) ProtoMessage() {}
 
-func (x *SendBungeeCommandRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveServerEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,26 +1572,30 @@ func (x *SendBungeeCommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBungeeCommandRequest.ProtoReflect.Descriptor instead.
-func (*SendBungeeCommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServerEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveServerEntryResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SendBungeeCommandRequest) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
-}
-
-type SendBungeeCommandResponse struct {
+// --
+// Bungee Entry
+// --
+type BungeeEntryStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type     BungeeEntryStream_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.BungeeEntryStream_Type" json:"type,omitempty"`
+	Entry    *BungeeEntry           `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Command  string                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Sequence uint64                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// not set for COMMAND
+	Revision  int64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *SendBungeeCommandResponse) Reset() {
-	*x = SendBungeeCommandResponse{}
+func (x *BungeeEntryStream) Reset() {
+	*x = BungeeEntryStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,13 +1603,13 @@ func (x *SendBungeeCommandResponse) Reset() {
 	}
 }
 
-func (x *SendBungeeCommandResponse) String() string {
+func (x *BungeeEntryStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBungeeCommandResponse) ProtoMessage() {}
+func (*BungeeEntryStream) ProtoMessage() {}
 
-func (x *SendBungeeCommandResponse) ProtoReflect() protoreflect.Message {
+func (x *BungeeEntryStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1061,21 +1621,66 @@ func (x *SendBungeeCommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBungeeCommandResponse.ProtoReflect.Descriptor instead.
-func (*SendBungeeCommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BungeeEntryStream.ProtoReflect.Descriptor instead.
+func (*BungeeEntryStream) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{16}
 }
 
-type SetMotdRequest struct {
+func (x *BungeeEntryStream) GetType() BungeeEntryStream_Type {
+	if x != nil {
+		return x.Type
+	}
+	return BungeeEntryStream_SYNC
+}
+
+func (x *BungeeEntryStream) GetEntry() *BungeeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *BungeeEntryStream) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *BungeeEntryStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BungeeEntryStream) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *BungeeEntryStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type BungeeEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Motd string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
+	Motd    string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
+	Favicon string `protobuf:"bytes,2,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	// network-wide whitelist enabled
+	Whitelist bool `protobuf:"varint,3,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
 }
 
-func (x *SetMotdRequest) Reset() {
-	*x = SetMotdRequest{}
+func (x *BungeeEntry) Reset() {
+	*x = BungeeEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1083,13 +1688,13 @@ func (x *SetMotdRequest) Reset() {
 	}
 }
 
-func (x *SetMotdRequest) String() string {
+func (x *BungeeEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMotdRequest) ProtoMessage() {}
+func (*BungeeEntry) ProtoMessage() {}
 
-func (x *SetMotdRequest) ProtoReflect() protoreflect.Message {
+func (x *BungeeEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,26 +1706,40 @@ func (x *SetMotdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMotdRequest.ProtoReflect.Descriptor instead.
-func (*SetMotdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BungeeEntry.ProtoReflect.Descriptor instead.
+func (*BungeeEntry) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SetMotdRequest) GetMotd() string {
+func (x *BungeeEntry) GetMotd() string {
 	if x != nil {
 		return x.Motd
 	}
 	return ""
 }
 
-type SetMotdResponse struct {
+func (x *BungeeEntry) GetFavicon() string {
+	if x != nil {
+		return x.Favicon
+	}
+	return ""
+}
+
+func (x *BungeeEntry) GetWhitelist() bool {
+	if x != nil {
+		return x.Whitelist
+	}
+	return false
+}
+
+type GetBungeeEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetMotdResponse) Reset() {
-	*x = SetMotdResponse{}
+func (x *GetBungeeEntryRequest) Reset() {
+	*x = GetBungeeEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1128,13 +1747,13 @@ func (x *SetMotdResponse) Reset() {
 	}
 }
 
-func (x *SetMotdResponse) String() string {
+func (x *GetBungeeEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMotdResponse) ProtoMessage() {}
+func (*GetBungeeEntryRequest) ProtoMessage() {}
 
-func (x *SetMotdResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBungeeEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1146,21 +1765,21 @@ func (x *SetMotdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMotdResponse.ProtoReflect.Descriptor instead.
-func (*SetMotdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBungeeEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetBungeeEntryRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{18}
 }
 
-type SetFaviconRequest struct {
+type GetBungeeEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Favicon string `protobuf:"bytes,1,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	Entry *BungeeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *SetFaviconRequest) Reset() {
-	*x = SetFaviconRequest{}
+func (x *GetBungeeEntryResponse) Reset() {
+	*x = GetBungeeEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1168,13 +1787,13 @@ func (x *SetFaviconRequest) Reset() {
 	}
 }
 
-func (x *SetFaviconRequest) String() string {
+func (x *GetBungeeEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFaviconRequest) ProtoMessage() {}
+func (*GetBungeeEntryResponse) ProtoMessage() {}
 
-func (x *SetFaviconRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBungeeEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1186,26 +1805,28 @@ func (x *SetFaviconRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFaviconRequest.ProtoReflect.Descriptor instead.
-func (*SetFaviconRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBungeeEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetBungeeEntryResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SetFaviconRequest) GetFavicon() string {
+func (x *GetBungeeEntryResponse) GetEntry() *BungeeEntry {
 	if x != nil {
-		return x.Favicon
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type SetFaviconResponse struct {
+type SendBungeeCommandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
 }
 
-func (x *SetFaviconResponse) Reset() {
-	*x = SetFaviconResponse{}
+func (x *SendBungeeCommandRequest) Reset() {
+	*x = SendBungeeCommandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,13 +1834,13 @@ func (x *SetFaviconResponse) Reset() {
 	}
 }
 
-func (x *SetFaviconResponse) String() string {
+func (x *SendBungeeCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFaviconResponse) ProtoMessage() {}
+func (*SendBungeeCommandRequest) ProtoMessage() {}
 
-func (x *SetFaviconResponse) ProtoReflect() protoreflect.Message {
+func (x *SendBungeeCommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,22 +1852,26 @@ func (x *SetFaviconResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFaviconResponse.ProtoReflect.Descriptor instead.
-func (*SetFaviconResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendBungeeCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendBungeeCommandRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{20}
 }
 
-type SetLockdownRequest struct {
+func (x *SendBungeeCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type SendBungeeCommandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Lockdown *Lockdown `protobuf:"bytes,2,opt,name=lockdown,proto3" json:"lockdown,omitempty"`
 }
 
-func (x *SetLockdownRequest) Reset() {
-	*x = SetLockdownRequest{}
+func (x *SendBungeeCommandResponse) Reset() {
+	*x = SendBungeeCommandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1254,13 +1879,13 @@ func (x *SetLockdownRequest) Reset() {
 	}
 }
 
-func (x *SetLockdownRequest) String() string {
+func (x *SendBungeeCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLockdownRequest) ProtoMessage() {}
+func (*SendBungeeCommandResponse) ProtoMessage() {}
 
-func (x *SetLockdownRequest) ProtoReflect() protoreflect.Message {
+func (x *SendBungeeCommandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,35 +1897,21 @@ func (x *SetLockdownRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLockdownRequest.ProtoReflect.Descriptor instead.
-func (*SetLockdownRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendBungeeCommandResponse.ProtoReflect.Descriptor instead.
+func (*SendBungeeCommandResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SetLockdownRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SetLockdownRequest) GetLockdown() *Lockdown {
-	if x != nil {
-		return x.Lockdown
-	}
-	return nil
-}
-
-type SetLockdownResponse struct {
+type SetMotdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Motd string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
 }
 
-func (x *SetLockdownResponse) Reset() {
-	*x = SetLockdownResponse{}
+func (x *SetMotdRequest) Reset() {
+	*x = SetMotdRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,13 +1919,13 @@ func (x *SetLockdownResponse) Reset() {
 	}
 }
 
-func (x *SetLockdownResponse) String() string {
+func (x *SetMotdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLockdownResponse) ProtoMessage() {}
+func (*SetMotdRequest) ProtoMessage() {}
 
-func (x *SetLockdownResponse) ProtoReflect() protoreflect.Message {
+func (x *SetMotdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1326,32 +1937,26 @@ func (x *SetLockdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLockdownResponse.ProtoReflect.Descriptor instead.
-func (*SetLockdownResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMotdRequest.ProtoReflect.Descriptor instead.
+func (*SetMotdRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SetLockdownResponse) GetEntry() *ServerEntry {
+func (x *SetMotdRequest) GetMotd() string {
 	if x != nil {
-		return x.Entry
+		return x.Motd
 	}
-	return nil
+	return ""
 }
 
-// IP Lookup
-type IPLookupResult struct {
+type SetMotdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	IpAddress    string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
-	Isp          string `protobuf:"bytes,2,opt,name=isp,proto3" json:"isp,omitempty"`
-	IsSuspicious bool   `protobuf:"varint,3,opt,name=isSuspicious,proto3" json:"isSuspicious,omitempty"`
-	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *IPLookupResult) Reset() {
-	*x = IPLookupResult{}
+func (x *SetMotdResponse) Reset() {
+	*x = SetMotdResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,13 +1964,13 @@ func (x *IPLookupResult) Reset() {
 	}
 }
 
-func (x *IPLookupResult) String() string {
+func (x *SetMotdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPLookupResult) ProtoMessage() {}
+func (*SetMotdResponse) ProtoMessage() {}
 
-func (x *IPLookupResult) ProtoReflect() protoreflect.Message {
+func (x *SetMotdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1377,49 +1982,21 @@ func (x *IPLookupResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPLookupResult.ProtoReflect.Descriptor instead.
-func (*IPLookupResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMotdResponse.ProtoReflect.Descriptor instead.
+func (*SetMotdResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *IPLookupResult) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
-}
-
-func (x *IPLookupResult) GetIsp() string {
-	if x != nil {
-		return x.Isp
-	}
-	return ""
-}
-
-func (x *IPLookupResult) GetIsSuspicious() bool {
-	if x != nil {
-		return x.IsSuspicious
-	}
-	return false
-}
-
-func (x *IPLookupResult) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type IPLookupRequest struct {
+type SetFaviconRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IpAddress string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Favicon string `protobuf:"bytes,1,opt,name=favicon,proto3" json:"favicon,omitempty"`
 }
 
-func (x *IPLookupRequest) Reset() {
-	*x = IPLookupRequest{}
+func (x *SetFaviconRequest) Reset() {
+	*x = SetFaviconRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1427,13 +2004,13 @@ func (x *IPLookupRequest) Reset() {
 	}
 }
 
-func (x *IPLookupRequest) String() string {
+func (x *SetFaviconRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPLookupRequest) ProtoMessage() {}
+func (*SetFaviconRequest) ProtoMessage() {}
 
-func (x *IPLookupRequest) ProtoReflect() protoreflect.Message {
+func (x *SetFaviconRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1445,28 +2022,26 @@ func (x *IPLookupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPLookupRequest.ProtoReflect.Descriptor instead.
-func (*IPLookupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFaviconRequest.ProtoReflect.Descriptor instead.
+func (*SetFaviconRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *IPLookupRequest) GetIpAddress() string {
+func (x *SetFaviconRequest) GetFavicon() string {
 	if x != nil {
-		return x.IpAddress
+		return x.Favicon
 	}
 	return ""
 }
 
-type IPLookupResponse struct {
+type SetFaviconResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Result *IPLookupResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *IPLookupResponse) Reset() {
-	*x = IPLookupResponse{}
+func (x *SetFaviconResponse) Reset() {
+	*x = SetFaviconResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1474,13 +2049,13 @@ func (x *IPLookupResponse) Reset() {
 	}
 }
 
-func (x *IPLookupResponse) String() string {
+func (x *SetFaviconResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPLookupResponse) ProtoMessage() {}
+func (*SetFaviconResponse) ProtoMessage() {}
 
-func (x *IPLookupResponse) ProtoReflect() protoreflect.Message {
+func (x *SetFaviconResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,31 +2067,26 @@ func (x *IPLookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPLookupResponse.ProtoReflect.Descriptor instead.
-func (*IPLookupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFaviconResponse.ProtoReflect.Descriptor instead.
+func (*SetFaviconResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *IPLookupResponse) GetResult() *IPLookupResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-// TabList
-type PlayerProperty struct {
+// Whitelisted by UUID or name (name: for players never joined)
+type WhitelistEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	AddedBy    string `protobuf:"bytes,3,opt,name=addedBy,proto3" json:"addedBy,omitempty"`
+	// unix time (seconds)
+	CreatedAt int64 `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *PlayerProperty) Reset() {
-	*x = PlayerProperty{}
+func (x *WhitelistEntry) Reset() {
+	*x = WhitelistEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1524,13 +2094,13 @@ func (x *PlayerProperty) Reset() {
 	}
 }
 
-func (x *PlayerProperty) String() string {
+func (x *WhitelistEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerProperty) ProtoMessage() {}
+func (*WhitelistEntry) ProtoMessage() {}
 
-func (x *PlayerProperty) ProtoReflect() protoreflect.Message {
+func (x *WhitelistEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,47 +2112,49 @@ func (x *PlayerProperty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerProperty.ProtoReflect.Descriptor instead.
-func (*PlayerProperty) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhitelistEntry.ProtoReflect.Descriptor instead.
+func (*WhitelistEntry) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PlayerProperty) GetName() string {
+func (x *WhitelistEntry) GetPlayerUUID() string {
 	if x != nil {
-		return x.Name
+		return x.PlayerUUID
 	}
 	return ""
 }
 
-func (x *PlayerProperty) GetValue() string {
+func (x *WhitelistEntry) GetPlayerName() string {
 	if x != nil {
-		return x.Value
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *PlayerProperty) GetSignature() string {
+func (x *WhitelistEntry) GetAddedBy() string {
 	if x != nil {
-		return x.Signature
+		return x.AddedBy
 	}
 	return ""
 }
 
-type PlayerProfile struct {
+func (x *WhitelistEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SetWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerUUID    string            `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
-	PlayerName    string            `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
-	PlayerLatency int64             `protobuf:"varint,3,opt,name=playerLatency,proto3" json:"playerLatency,omitempty"`
-	CurrentServer string            `protobuf:"bytes,4,opt,name=currentServer,proto3" json:"currentServer,omitempty"`
-	Properties    []*PlayerProperty `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
-	Hide          bool              `protobuf:"varint,6,opt,name=hide,proto3" json:"hide,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *PlayerProfile) Reset() {
-	*x = PlayerProfile{}
+func (x *SetWhitelistRequest) Reset() {
+	*x = SetWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1590,13 +2162,13 @@ func (x *PlayerProfile) Reset() {
 	}
 }
 
-func (x *PlayerProfile) String() string {
+func (x *SetWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerProfile) ProtoMessage() {}
+func (*SetWhitelistRequest) ProtoMessage() {}
 
-func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+func (x *SetWhitelistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1608,63 +2180,28 @@ func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
-func (*PlayerProfile) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*SetWhitelistRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PlayerProfile) GetPlayerUUID() string {
-	if x != nil {
-		return x.PlayerUUID
-	}
-	return ""
-}
-
-func (x *PlayerProfile) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
-	}
-	return ""
-}
-
-func (x *PlayerProfile) GetPlayerLatency() int64 {
-	if x != nil {
-		return x.PlayerLatency
-	}
-	return 0
-}
-
-func (x *PlayerProfile) GetCurrentServer() string {
-	if x != nil {
-		return x.CurrentServer
-	}
-	return ""
-}
-
-func (x *PlayerProfile) GetProperties() []*PlayerProperty {
-	if x != nil {
-		return x.Properties
-	}
-	return nil
-}
-
-func (x *PlayerProfile) GetHide() bool {
+func (x *SetWhitelistRequest) GetEnabled() bool {
 	if x != nil {
-		return x.Hide
+		return x.Enabled
 	}
 	return false
 }
 
-type PlayerLoginRequest struct {
+type SetWhitelistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Entry *BungeeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *PlayerLoginRequest) Reset() {
-	*x = PlayerLoginRequest{}
+func (x *SetWhitelistResponse) Reset() {
+	*x = SetWhitelistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1672,13 +2209,13 @@ func (x *PlayerLoginRequest) Reset() {
 	}
 }
 
-func (x *PlayerLoginRequest) String() string {
+func (x *SetWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerLoginRequest) ProtoMessage() {}
+func (*SetWhitelistResponse) ProtoMessage() {}
 
-func (x *PlayerLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *SetWhitelistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1690,26 +2227,28 @@ func (x *PlayerLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerLoginRequest.ProtoReflect.Descriptor instead.
-func (*PlayerLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*SetWhitelistResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PlayerLoginRequest) GetProfile() *PlayerProfile {
+func (x *SetWhitelistResponse) GetEntry() *BungeeEntry {
 	if x != nil {
-		return x.Profile
+		return x.Entry
 	}
 	return nil
 }
 
-type PlayerLoginResponse struct {
+type AddToWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Entry *WhitelistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *PlayerLoginResponse) Reset() {
-	*x = PlayerLoginResponse{}
+func (x *AddToWhitelistRequest) Reset() {
+	*x = AddToWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1717,13 +2256,13 @@ func (x *PlayerLoginResponse) Reset() {
 	}
 }
 
-func (x *PlayerLoginResponse) String() string {
+func (x *AddToWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerLoginResponse) ProtoMessage() {}
+func (*AddToWhitelistRequest) ProtoMessage() {}
 
-func (x *PlayerLoginResponse) ProtoReflect() protoreflect.Message {
+func (x *AddToWhitelistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1735,21 +2274,28 @@ func (x *PlayerLoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerLoginResponse.ProtoReflect.Descriptor instead.
-func (*PlayerLoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWhitelistRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{29}
 }
 
-type PlayerQuitRequest struct {
+func (x *AddToWhitelistRequest) GetEntry() *WhitelistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type AddToWhitelistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Entry *WhitelistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *PlayerQuitRequest) Reset() {
-	*x = PlayerQuitRequest{}
+func (x *AddToWhitelistResponse) Reset() {
+	*x = AddToWhitelistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1757,13 +2303,13 @@ func (x *PlayerQuitRequest) Reset() {
 	}
 }
 
-func (x *PlayerQuitRequest) String() string {
+func (x *AddToWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerQuitRequest) ProtoMessage() {}
+func (*AddToWhitelistResponse) ProtoMessage() {}
 
-func (x *PlayerQuitRequest) ProtoReflect() protoreflect.Message {
+func (x *AddToWhitelistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,26 +2321,29 @@ func (x *PlayerQuitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerQuitRequest.ProtoReflect.Descriptor instead.
-func (*PlayerQuitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWhitelistResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PlayerQuitRequest) GetProfile() *PlayerProfile {
+func (x *AddToWhitelistResponse) GetEntry() *WhitelistEntry {
 	if x != nil {
-		return x.Profile
+		return x.Entry
 	}
 	return nil
 }
 
-type PlayerQuitResponse struct {
+type RemoveFromWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
 }
 
-func (x *PlayerQuitResponse) Reset() {
-	*x = PlayerQuitResponse{}
+func (x *RemoveFromWhitelistRequest) Reset() {
+	*x = RemoveFromWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1802,13 +2351,13 @@ func (x *PlayerQuitResponse) Reset() {
 	}
 }
 
-func (x *PlayerQuitResponse) String() string {
+func (x *RemoveFromWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerQuitResponse) ProtoMessage() {}
+func (*RemoveFromWhitelistRequest) ProtoMessage() {}
 
-func (x *PlayerQuitResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveFromWhitelistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1820,19 +2369,33 @@ func (x *PlayerQuitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerQuitResponse.ProtoReflect.Descriptor instead.
-func (*PlayerQuitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveFromWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWhitelistRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{31}
 }
 
-type FetchAllPlayersRequest struct {
+func (x *RemoveFromWhitelistRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *RemoveFromWhitelistRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type RemoveFromWhitelistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FetchAllPlayersRequest) Reset() {
-	*x = FetchAllPlayersRequest{}
+func (x *RemoveFromWhitelistResponse) Reset() {
+	*x = RemoveFromWhitelistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1840,13 +2403,13 @@ func (x *FetchAllPlayersRequest) Reset() {
 	}
 }
 
-func (x *FetchAllPlayersRequest) String() string {
+func (x *RemoveFromWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAllPlayersRequest) ProtoMessage() {}
+func (*RemoveFromWhitelistResponse) ProtoMessage() {}
 
-func (x *FetchAllPlayersRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveFromWhitelistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1858,21 +2421,19 @@ func (x *FetchAllPlayersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAllPlayersRequest.ProtoReflect.Descriptor instead.
-func (*FetchAllPlayersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveFromWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWhitelistResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{32}
 }
 
-type FetchAllPlayersResponse struct {
+type GetWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Profiles []*PlayerProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 }
 
-func (x *FetchAllPlayersResponse) Reset() {
-	*x = FetchAllPlayersResponse{}
+func (x *GetWhitelistRequest) Reset() {
+	*x = GetWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1880,13 +2441,13 @@ func (x *FetchAllPlayersResponse) Reset() {
 	}
 }
 
-func (x *FetchAllPlayersResponse) String() string {
+func (x *GetWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchAllPlayersResponse) ProtoMessage() {}
+func (*GetWhitelistRequest) ProtoMessage() {}
 
-func (x *FetchAllPlayersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetWhitelistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1898,28 +2459,21 @@ func (x *FetchAllPlayersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchAllPlayersResponse.ProtoReflect.Descriptor instead.
-func (*FetchAllPlayersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*GetWhitelistRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *FetchAllPlayersResponse) GetProfiles() []*PlayerProfile {
-	if x != nil {
-		return x.Profiles
-	}
-	return nil
-}
-
-type UpdateAllPlayersRequest struct {
+type GetWhitelistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profiles []*PlayerProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Entries []*WhitelistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *UpdateAllPlayersRequest) Reset() {
-	*x = UpdateAllPlayersRequest{}
+func (x *GetWhitelistResponse) Reset() {
+	*x = GetWhitelistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1927,13 +2481,13 @@ func (x *UpdateAllPlayersRequest) Reset() {
 	}
 }
 
-func (x *UpdateAllPlayersRequest) String() string {
+func (x *GetWhitelistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAllPlayersRequest) ProtoMessage() {}
+func (*GetWhitelistResponse) ProtoMessage() {}
 
-func (x *UpdateAllPlayersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWhitelistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1945,26 +2499,29 @@ func (x *UpdateAllPlayersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAllPlayersRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAllPlayersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*GetWhitelistResponse) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateAllPlayersRequest) GetProfiles() []*PlayerProfile {
+func (x *GetWhitelistResponse) GetEntries() []*WhitelistEntry {
 	if x != nil {
-		return x.Profiles
+		return x.Entries
 	}
 	return nil
 }
 
-type UpdateAllPlayersResponse struct {
+type SetLockdownRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Lockdown *Lockdown `protobuf:"bytes,2,opt,name=lockdown,proto3" json:"lockdown,omitempty"`
 }
 
-func (x *UpdateAllPlayersResponse) Reset() {
-	*x = UpdateAllPlayersResponse{}
+func (x *SetLockdownRequest) Reset() {
+	*x = SetLockdownRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1972,13 +2529,13 @@ func (x *UpdateAllPlayersResponse) Reset() {
 	}
 }
 
-func (x *UpdateAllPlayersResponse) String() string {
+func (x *SetLockdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAllPlayersResponse) ProtoMessage() {}
+func (*SetLockdownRequest) ProtoMessage() {}
 
-func (x *UpdateAllPlayersResponse) ProtoReflect() protoreflect.Message {
+func (x *SetLockdownRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1990,22 +2547,35 @@ func (x *UpdateAllPlayersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAllPlayersResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAllPlayersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetLockdownRequest.ProtoReflect.Descriptor instead.
+func (*SetLockdownRequest) Descriptor() ([]byte, []int) {
 	return file_nebulapb_proto_rawDescGZIP(), []int{35}
 }
 
-type ServerStatus_Version struct {
+func (x *SetLockdownRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetLockdownRequest) GetLockdown() *Lockdown {
+	if x != nil {
+		return x.Lockdown
+	}
+	return nil
+}
+
+type SetLockdownResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Protocol int32  `protobuf:"varint,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Entry *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *ServerStatus_Version) Reset() {
-	*x = ServerStatus_Version{}
+func (x *SetLockdownResponse) Reset() {
+	*x = SetLockdownResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_nebulapb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2013,485 +2583,7214 @@ func (x *ServerStatus_Version) Reset() {
 	}
 }
 
-func (x *ServerStatus_Version) String() string {
+func (x *SetLockdownResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerStatus_Version) ProtoMessage() {}
+func (*SetLockdownResponse) ProtoMessage() {}
 
-func (x *ServerStatus_Version) ProtoReflect() protoreflect.Message {
+func (x *SetLockdownResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nebulapb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServerStatus_Version.ProtoReflect.Descriptor instead.
-func (*ServerStatus_Version) Descriptor() ([]byte, []int) {
-	return file_nebulapb_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *ServerStatus_Version) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ServerStatus_Version) GetProtocol() int32 {
-	if x != nil {
-		return x.Protocol
-	}
-	return 0
-}
-
-type ServerStatus_Players struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Max    int32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
-	Online int32 `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"` // should be have sample?
-}
-
-func (x *ServerStatus_Players) Reset() {
-	*x = ServerStatus_Players{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_nebulapb_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ServerStatus_Players) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServerStatus_Players) ProtoMessage() {}
-
-func (x *ServerStatus_Players) ProtoReflect() protoreflect.Message {
-	mi := &file_nebulapb_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLockdownResponse.ProtoReflect.Descriptor instead.
+func (*SetLockdownResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetLockdownResponse) GetEntry() *ServerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// IP Lookup
+type IPLookupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress    string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Isp          string `protobuf:"bytes,2,opt,name=isp,proto3" json:"isp,omitempty"`
+	IsSuspicious bool   `protobuf:"varint,3,opt,name=isSuspicious,proto3" json:"isSuspicious,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// lookup failed, isSuspicious is decided by fail policy
+	LookupFailed bool   `protobuf:"varint,5,opt,name=lookupFailed,proto3" json:"lookupFailed,omitempty"`
+	FailClosed   bool   `protobuf:"varint,6,opt,name=failClosed,proto3" json:"failClosed,omitempty"`
+	Error        string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *IPLookupResult) Reset() {
+	*x = IPLookupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPLookupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPLookupResult) ProtoMessage() {}
+
+func (x *IPLookupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPLookupResult.ProtoReflect.Descriptor instead.
+func (*IPLookupResult) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *IPLookupResult) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *IPLookupResult) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *IPLookupResult) GetIsSuspicious() bool {
+	if x != nil {
+		return x.IsSuspicious
+	}
+	return false
+}
+
+func (x *IPLookupResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IPLookupResult) GetLookupFailed() bool {
+	if x != nil {
+		return x.LookupFailed
+	}
+	return false
+}
+
+func (x *IPLookupResult) GetFailClosed() bool {
+	if x != nil {
+		return x.FailClosed
+	}
+	return false
+}
+
+func (x *IPLookupResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type IPLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+}
+
+func (x *IPLookupRequest) Reset() {
+	*x = IPLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPLookupRequest) ProtoMessage() {}
+
+func (x *IPLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPLookupRequest.ProtoReflect.Descriptor instead.
+func (*IPLookupRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *IPLookupRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type IPLookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *IPLookupResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *IPLookupResponse) Reset() {
+	*x = IPLookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPLookupResponse) ProtoMessage() {}
+
+func (x *IPLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPLookupResponse.ProtoReflect.Descriptor instead.
+func (*IPLookupResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IPLookupResponse) GetResult() *IPLookupResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type IPFilterEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action IPFilterEntry_Action `protobuf:"varint,2,opt,name=action,proto3,enum=nebulapb.IPFilterEntry_Action" json:"action,omitempty"`
+	// IP address or CIDR (e.g. 192.0.2.0/24, 2001:db8::/32)
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// unix time (0: permanent)
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *IPFilterEntry) Reset() {
+	*x = IPFilterEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPFilterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPFilterEntry) ProtoMessage() {}
+
+func (x *IPFilterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPFilterEntry.ProtoReflect.Descriptor instead.
+func (*IPFilterEntry) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *IPFilterEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IPFilterEntry) GetAction() IPFilterEntry_Action {
+	if x != nil {
+		return x.Action
+	}
+	return IPFilterEntry_ALLOW
+}
+
+func (x *IPFilterEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IPFilterEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IPFilterEntry) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type AddIPFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *IPFilterEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddIPFilterRequest) Reset() {
+	*x = AddIPFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddIPFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIPFilterRequest) ProtoMessage() {}
+
+func (x *AddIPFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIPFilterRequest.ProtoReflect.Descriptor instead.
+func (*AddIPFilterRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddIPFilterRequest) GetEntry() *IPFilterEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type AddIPFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *IPFilterEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *AddIPFilterResponse) Reset() {
+	*x = AddIPFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddIPFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddIPFilterResponse) ProtoMessage() {}
+
+func (x *AddIPFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIPFilterResponse.ProtoReflect.Descriptor instead.
+func (*AddIPFilterResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AddIPFilterResponse) GetEntry() *IPFilterEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type RemoveIPFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RemoveIPFilterRequest) Reset() {
+	*x = RemoveIPFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveIPFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveIPFilterRequest) ProtoMessage() {}
+
+func (x *RemoveIPFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveIPFilterRequest.ProtoReflect.Descriptor instead.
+func (*RemoveIPFilterRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RemoveIPFilterRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type RemoveIPFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveIPFilterResponse) Reset() {
+	*x = RemoveIPFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveIPFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveIPFilterResponse) ProtoMessage() {}
+
+func (x *RemoveIPFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveIPFilterResponse.ProtoReflect.Descriptor instead.
+func (*RemoveIPFilterResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{44}
+}
+
+type ListIPFiltersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListIPFiltersRequest) Reset() {
+	*x = ListIPFiltersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIPFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIPFiltersRequest) ProtoMessage() {}
+
+func (x *ListIPFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIPFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListIPFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{45}
+}
+
+type ListIPFiltersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*IPFilterEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListIPFiltersResponse) Reset() {
+	*x = ListIPFiltersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIPFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIPFiltersResponse) ProtoMessage() {}
+
+func (x *ListIPFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIPFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListIPFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListIPFiltersResponse) GetEntries() []*IPFilterEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Join rate limit (per IP, per subnet and global)
+type CheckConnectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress  string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	PlayerUUID string `protobuf:"bytes,2,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+}
+
+func (x *CheckConnectionRequest) Reset() {
+	*x = CheckConnectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConnectionRequest) ProtoMessage() {}
+
+func (x *CheckConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConnectionRequest.ProtoReflect.Descriptor instead.
+func (*CheckConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CheckConnectionRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *CheckConnectionRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+type CheckConnectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// unix time (temporary ban)
+	BannedUntil int64 `protobuf:"varint,3,opt,name=bannedUntil,proto3" json:"bannedUntil,omitempty"`
+}
+
+func (x *CheckConnectionResponse) Reset() {
+	*x = CheckConnectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConnectionResponse) ProtoMessage() {}
+
+func (x *CheckConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConnectionResponse.ProtoReflect.Descriptor instead.
+func (*CheckConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CheckConnectionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckConnectionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckConnectionResponse) GetBannedUntil() int64 {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return 0
+}
+
+type GetIPLookupStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetIPLookupStatsRequest) Reset() {
+	*x = GetIPLookupStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIPLookupStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIPLookupStatsRequest) ProtoMessage() {}
+
+func (x *GetIPLookupStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIPLookupStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetIPLookupStatsRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{49}
+}
+
+type GetIPLookupStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// memory cache
+	Hits uint64 `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	// persistent store (redis / mysql)
+	StoreHits uint64 `protobuf:"varint,2,opt,name=storeHits,proto3" json:"storeHits,omitempty"`
+	// cached lookup failure
+	NegativeHits uint64 `protobuf:"varint,3,opt,name=negativeHits,proto3" json:"negativeHits,omitempty"`
+	// external lookup
+	Misses uint64 `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	// waited for concurrent lookup of same ip
+	Shared uint64 `protobuf:"varint,5,opt,name=shared,proto3" json:"shared,omitempty"`
+	Size   int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// removed by IP_LOOKUP_CACHE_SIZE limit
+	Evictions uint64 `protobuf:"varint,7,opt,name=evictions,proto3" json:"evictions,omitempty"`
+}
+
+func (x *GetIPLookupStatsResponse) Reset() {
+	*x = GetIPLookupStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIPLookupStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIPLookupStatsResponse) ProtoMessage() {}
+
+func (x *GetIPLookupStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIPLookupStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetIPLookupStatsResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetIPLookupStatsResponse) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetStoreHits() uint64 {
+	if x != nil {
+		return x.StoreHits
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetNegativeHits() uint64 {
+	if x != nil {
+		return x.NegativeHits
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetShared() uint64 {
+	if x != nil {
+		return x.Shared
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetIPLookupStatsResponse) GetEvictions() uint64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+// TabList
+type PlayerProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value     string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *PlayerProperty) Reset() {
+	*x = PlayerProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProperty) ProtoMessage() {}
+
+func (x *PlayerProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProperty.ProtoReflect.Descriptor instead.
+func (*PlayerProperty) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PlayerProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerProperty) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PlayerProperty) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type PlayerProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID    string            `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName    string            `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	PlayerLatency int64             `protobuf:"varint,3,opt,name=playerLatency,proto3" json:"playerLatency,omitempty"`
+	CurrentServer string            `protobuf:"bytes,4,opt,name=currentServer,proto3" json:"currentServer,omitempty"`
+	Properties    []*PlayerProperty `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	Hide          bool              `protobuf:"varint,6,opt,name=hide,proto3" json:"hide,omitempty"`
+}
+
+func (x *PlayerProfile) Reset() {
+	*x = PlayerProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProfile) ProtoMessage() {}
+
+func (x *PlayerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProfile.ProtoReflect.Descriptor instead.
+func (*PlayerProfile) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PlayerProfile) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetPlayerLatency() int64 {
+	if x != nil {
+		return x.PlayerLatency
+	}
+	return 0
+}
+
+func (x *PlayerProfile) GetCurrentServer() string {
+	if x != nil {
+		return x.CurrentServer
+	}
+	return ""
+}
+
+func (x *PlayerProfile) GetProperties() []*PlayerProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *PlayerProfile) GetHide() bool {
+	if x != nil {
+		return x.Hide
+	}
+	return false
+}
+
+// Proxy
+//
+// Proxy sends heartbeat periodically.
+// When heartbeat stopped, players logged in via the proxy are marked as offline.
+type ProxyHeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProxyId string `protobuf:"bytes,1,opt,name=proxyId,proto3" json:"proxyId,omitempty"`
+}
+
+func (x *ProxyHeartbeatRequest) Reset() {
+	*x = ProxyHeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyHeartbeatRequest) ProtoMessage() {}
+
+func (x *ProxyHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*ProxyHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ProxyHeartbeatRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+type ProxyHeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProxyHeartbeatResponse) Reset() {
+	*x = ProxyHeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyHeartbeatResponse) ProtoMessage() {}
+
+func (x *ProxyHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*ProxyHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{54}
+}
+
+// Mark all players on the proxy as offline (e.g. proxy restarted)
+type ResetProxyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProxyId string `protobuf:"bytes,1,opt,name=proxyId,proto3" json:"proxyId,omitempty"`
+}
+
+func (x *ResetProxyRequest) Reset() {
+	*x = ResetProxyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetProxyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetProxyRequest) ProtoMessage() {}
+
+func (x *ResetProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetProxyRequest.ProtoReflect.Descriptor instead.
+func (*ResetProxyRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResetProxyRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+type ResetProxyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *ResetProxyResponse) Reset() {
+	*x = ResetProxyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetProxyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetProxyResponse) ProtoMessage() {}
+
+func (x *ResetProxyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetProxyResponse.ProtoReflect.Descriptor instead.
+func (*ResetProxyResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ResetProxyResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type PlayerLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile   *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	ProxyId   string         `protobuf:"bytes,2,opt,name=proxyId,proto3" json:"proxyId,omitempty"`
+	IpAddress string         `protobuf:"bytes,3,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+}
+
+func (x *PlayerLoginRequest) Reset() {
+	*x = PlayerLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLoginRequest) ProtoMessage() {}
+
+func (x *PlayerLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLoginRequest.ProtoReflect.Descriptor instead.
+func (*PlayerLoginRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PlayerLoginRequest) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *PlayerLoginRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+func (x *PlayerLoginRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type PlayerLoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PlayerLoginResponse) Reset() {
+	*x = PlayerLoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLoginResponse) ProtoMessage() {}
+
+func (x *PlayerLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLoginResponse.ProtoReflect.Descriptor instead.
+func (*PlayerLoginResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{58}
+}
+
+type PlayerQuitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *PlayerQuitRequest) Reset() {
+	*x = PlayerQuitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerQuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerQuitRequest) ProtoMessage() {}
+
+func (x *PlayerQuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerQuitRequest.ProtoReflect.Descriptor instead.
+func (*PlayerQuitRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PlayerQuitRequest) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type PlayerQuitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PlayerQuitResponse) Reset() {
+	*x = PlayerQuitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerQuitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerQuitResponse) ProtoMessage() {}
+
+func (x *PlayerQuitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerQuitResponse.ProtoReflect.Descriptor instead.
+func (*PlayerQuitResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{60}
+}
+
+type PlayerSwitchServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	FromServer string `protobuf:"bytes,2,opt,name=fromServer,proto3" json:"fromServer,omitempty"`
+	ToServer   string `protobuf:"bytes,3,opt,name=toServer,proto3" json:"toServer,omitempty"`
+}
+
+func (x *PlayerSwitchServerRequest) Reset() {
+	*x = PlayerSwitchServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSwitchServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSwitchServerRequest) ProtoMessage() {}
+
+func (x *PlayerSwitchServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSwitchServerRequest.ProtoReflect.Descriptor instead.
+func (*PlayerSwitchServerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PlayerSwitchServerRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *PlayerSwitchServerRequest) GetFromServer() string {
+	if x != nil {
+		return x.FromServer
+	}
+	return ""
+}
+
+func (x *PlayerSwitchServerRequest) GetToServer() string {
+	if x != nil {
+		return x.ToServer
+	}
+	return ""
+}
+
+type PlayerSwitchServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *PlayerSwitchServerResponse) Reset() {
+	*x = PlayerSwitchServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSwitchServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSwitchServerResponse) ProtoMessage() {}
+
+func (x *PlayerSwitchServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSwitchServerResponse.ProtoReflect.Descriptor instead.
+func (*PlayerSwitchServerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PlayerSwitchServerResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type FetchAllPlayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// include hidden (vanished) players (for staff)
+	IncludeHidden bool `protobuf:"varint,1,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *FetchAllPlayersRequest) Reset() {
+	*x = FetchAllPlayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchAllPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAllPlayersRequest) ProtoMessage() {}
+
+func (x *FetchAllPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAllPlayersRequest.ProtoReflect.Descriptor instead.
+func (*FetchAllPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FetchAllPlayersRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type FetchAllPlayersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*PlayerProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *FetchAllPlayersResponse) Reset() {
+	*x = FetchAllPlayersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchAllPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchAllPlayersResponse) ProtoMessage() {}
+
+func (x *FetchAllPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchAllPlayersResponse.ProtoReflect.Descriptor instead.
+func (*FetchAllPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FetchAllPlayersResponse) GetProfiles() []*PlayerProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// profile.currentServer is empty when player is offline
+// (hidden players look offline unless includeHidden is set)
+type GetPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID    string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	IncludeHidden bool   `protobuf:"varint,2,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *GetPlayerRequest) Reset() {
+	*x = GetPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerRequest) ProtoMessage() {}
+
+func (x *GetPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetPlayerRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *GetPlayerRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetPlayerResponse) Reset() {
+	*x = GetPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerResponse) ProtoMessage() {}
+
+func (x *GetPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPlayerResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// query: player UUID or name
+type FindPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// find hidden (vanished) players (for staff)
+	IncludeHidden bool `protobuf:"varint,2,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *FindPlayerRequest) Reset() {
+	*x = FindPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerRequest) ProtoMessage() {}
+
+func (x *FindPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerRequest.ProtoReflect.Descriptor instead.
+func (*FindPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FindPlayerRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *FindPlayerRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type FindPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Online  bool           `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	ProxyId string         `protobuf:"bytes,3,opt,name=proxyId,proto3" json:"proxyId,omitempty"`
+}
+
+func (x *FindPlayerResponse) Reset() {
+	*x = FindPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPlayerResponse) ProtoMessage() {}
+
+func (x *FindPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPlayerResponse.ProtoReflect.Descriptor instead.
+func (*FindPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FindPlayerResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *FindPlayerResponse) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *FindPlayerResponse) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+// target: playerUUID or playerName
+type SendPlayerMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	// chat component (json)
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SenderUUID string `protobuf:"bytes,4,opt,name=senderUUID,proto3" json:"senderUUID,omitempty"`
+	SenderName string `protobuf:"bytes,5,opt,name=senderName,proto3" json:"senderName,omitempty"`
+	// allow sending to hidden (vanished) players (for staff)
+	IncludeHidden bool `protobuf:"varint,6,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *SendPlayerMessageRequest) Reset() {
+	*x = SendPlayerMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPlayerMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPlayerMessageRequest) ProtoMessage() {}
+
+func (x *SendPlayerMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPlayerMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendPlayerMessageRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SendPlayerMessageRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *SendPlayerMessageRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SendPlayerMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendPlayerMessageRequest) GetSenderUUID() string {
+	if x != nil {
+		return x.SenderUUID
+	}
+	return ""
+}
+
+func (x *SendPlayerMessageRequest) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *SendPlayerMessageRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type SendPlayerMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *SendPlayerMessageResponse) Reset() {
+	*x = SendPlayerMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendPlayerMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendPlayerMessageResponse) ProtoMessage() {}
+
+func (x *SendPlayerMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendPlayerMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendPlayerMessageResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SendPlayerMessageResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Login address (hashed when PLAYER_ADDRESS_HMAC_KEY is set)
+type PlayerAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Address    string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Subnet     string `protobuf:"bytes,4,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	FirstSeen  int64  `protobuf:"varint,5,opt,name=firstSeen,proto3" json:"firstSeen,omitempty"`
+	LastSeen   int64  `protobuf:"varint,6,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Count      int64  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PlayerAddress) Reset() {
+	*x = PlayerAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAddress) ProtoMessage() {}
+
+func (x *PlayerAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAddress.ProtoReflect.Descriptor instead.
+func (*PlayerAddress) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PlayerAddress) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *PlayerAddress) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PlayerAddress) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *PlayerAddress) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *PlayerAddress) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PlayerAddress) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Players logged in from the address (or its /24, /64 subnet)
+type GetAccountsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress string `protobuf:"bytes,1,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+	Subnet    bool   `protobuf:"varint,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetAccountsByAddressRequest) Reset() {
+	*x = GetAccountsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsByAddressRequest) ProtoMessage() {}
+
+func (x *GetAccountsByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetAccountsByAddressRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *GetAccountsByAddressRequest) GetSubnet() bool {
+	if x != nil {
+		return x.Subnet
+	}
+	return false
+}
+
+func (x *GetAccountsByAddressRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAccountsByAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one entry per player (most recent address)
+	Addresses []*PlayerAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Hashed    bool             `protobuf:"varint,2,opt,name=hashed,proto3" json:"hashed,omitempty"`
+}
+
+func (x *GetAccountsByAddressResponse) Reset() {
+	*x = GetAccountsByAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountsByAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsByAddressResponse) ProtoMessage() {}
+
+func (x *GetAccountsByAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsByAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsByAddressResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetAccountsByAddressResponse) GetAddresses() []*PlayerAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GetAccountsByAddressResponse) GetHashed() bool {
+	if x != nil {
+		return x.Hashed
+	}
+	return false
+}
+
+type GetPlayerAddressesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	Limit      int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset     int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetPlayerAddressesRequest) Reset() {
+	*x = GetPlayerAddressesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerAddressesRequest) ProtoMessage() {}
+
+func (x *GetPlayerAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerAddressesRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPlayerAddressesRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *GetPlayerAddressesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPlayerAddressesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetPlayerAddressesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*PlayerAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Hashed    bool             `protobuf:"varint,2,opt,name=hashed,proto3" json:"hashed,omitempty"`
+}
+
+func (x *GetPlayerAddressesResponse) Reset() {
+	*x = GetPlayerAddressesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerAddressesResponse) ProtoMessage() {}
+
+func (x *GetPlayerAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerAddressesResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetPlayerAddressesResponse) GetAddresses() []*PlayerAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GetPlayerAddressesResponse) GetHashed() bool {
+	if x != nil {
+		return x.Hashed
+	}
+	return false
+}
+
+// Transfer instruction is published to the proxy which holds the player.
+type TransferPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID   string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	TargetServer string `protobuf:"bytes,2,opt,name=targetServer,proto3" json:"targetServer,omitempty"`
+}
+
+func (x *TransferPlayerRequest) Reset() {
+	*x = TransferPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPlayerRequest) ProtoMessage() {}
+
+func (x *TransferPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPlayerRequest.ProtoReflect.Descriptor instead.
+func (*TransferPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TransferPlayerRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *TransferPlayerRequest) GetTargetServer() string {
+	if x != nil {
+		return x.TargetServer
+	}
+	return ""
+}
+
+type TransferPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *TransferPlayerResponse) Reset() {
+	*x = TransferPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPlayerResponse) ProtoMessage() {}
+
+func (x *TransferPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPlayerResponse.ProtoReflect.Descriptor instead.
+func (*TransferPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TransferPlayerResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Transfer all players on fromServer to toServer
+type TransferServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromServer string `protobuf:"bytes,1,opt,name=fromServer,proto3" json:"fromServer,omitempty"`
+	ToServer   string `protobuf:"bytes,2,opt,name=toServer,proto3" json:"toServer,omitempty"`
+}
+
+func (x *TransferServerRequest) Reset() {
+	*x = TransferServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferServerRequest) ProtoMessage() {}
+
+func (x *TransferServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferServerRequest.ProtoReflect.Descriptor instead.
+func (*TransferServerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TransferServerRequest) GetFromServer() string {
+	if x != nil {
+		return x.FromServer
+	}
+	return ""
+}
+
+func (x *TransferServerRequest) GetToServer() string {
+	if x != nil {
+		return x.ToServer
+	}
+	return ""
+}
+
+type TransferServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUIDs []string `protobuf:"bytes,1,rep,name=playerUUIDs,proto3" json:"playerUUIDs,omitempty"`
+}
+
+func (x *TransferServerResponse) Reset() {
+	*x = TransferServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferServerResponse) ProtoMessage() {}
+
+func (x *TransferServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferServerResponse.ProtoReflect.Descriptor instead.
+func (*TransferServerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TransferServerResponse) GetPlayerUUIDs() []string {
+	if x != nil {
+		return x.PlayerUUIDs
+	}
+	return nil
+}
+
+// Lockdown server and move players to fallback servers until it becomes empty
+type DrainServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// candidate servers (fallback servers when empty)
+	FallbackGroup string `protobuf:"bytes,2,opt,name=fallbackGroup,proto3" json:"fallbackGroup,omitempty"`
+	// unix time (default: 60 seconds later)
+	Deadline int64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *DrainServerRequest) Reset() {
+	*x = DrainServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainServerRequest) ProtoMessage() {}
+
+func (x *DrainServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainServerRequest.ProtoReflect.Descriptor instead.
+func (*DrainServerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DrainServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DrainServerRequest) GetFallbackGroup() string {
+	if x != nil {
+		return x.FallbackGroup
+	}
+	return ""
+}
+
+func (x *DrainServerRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type DrainServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry       *ServerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	PlayerUUIDs []string     `protobuf:"bytes,2,rep,name=playerUUIDs,proto3" json:"playerUUIDs,omitempty"`
+	Completed   bool         `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Remaining   int32        `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *DrainServerResponse) Reset() {
+	*x = DrainServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainServerResponse) ProtoMessage() {}
+
+func (x *DrainServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainServerResponse.ProtoReflect.Descriptor instead.
+func (*DrainServerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DrainServerResponse) GetEntry() *ServerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *DrainServerResponse) GetPlayerUUIDs() []string {
+	if x != nil {
+		return x.PlayerUUIDs
+	}
+	return nil
+}
+
+func (x *DrainServerResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *DrainServerResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type SetPlayerHiddenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	Hidden     bool   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+}
+
+func (x *SetPlayerHiddenRequest) Reset() {
+	*x = SetPlayerHiddenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerHiddenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerHiddenRequest) ProtoMessage() {}
+
+func (x *SetPlayerHiddenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerHiddenRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerHiddenRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetPlayerHiddenRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *SetPlayerHiddenRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type SetPlayerHiddenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *SetPlayerHiddenResponse) Reset() {
+	*x = SetPlayerHiddenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPlayerHiddenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerHiddenResponse) ProtoMessage() {}
+
+func (x *SetPlayerHiddenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerHiddenResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerHiddenResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetPlayerHiddenResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// case-insensitive, latest owner of the name is returned
+// hidden players look offline unless includeHidden is set
+type GetPlayerByNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerName    string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	IncludeHidden bool   `protobuf:"varint,2,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *GetPlayerByNameRequest) Reset() {
+	*x = GetPlayerByNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByNameRequest) ProtoMessage() {}
+
+func (x *GetPlayerByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerByNameRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetPlayerByNameRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *GetPlayerByNameRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetPlayerByNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *PlayerProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetPlayerByNameResponse) Reset() {
+	*x = GetPlayerByNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerByNameResponse) ProtoMessage() {}
+
+func (x *GetPlayerByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerByNameResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetPlayerByNameResponse) GetProfile() *PlayerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListPlayersOnServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// default: 100, max: 1000
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// include hidden (vanished) players (for staff)
+	IncludeHidden bool `protobuf:"varint,4,opt,name=includeHidden,proto3" json:"includeHidden,omitempty"`
+}
+
+func (x *ListPlayersOnServerRequest) Reset() {
+	*x = ListPlayersOnServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersOnServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersOnServerRequest) ProtoMessage() {}
+
+func (x *ListPlayersOnServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersOnServerRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayersOnServerRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListPlayersOnServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListPlayersOnServerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPlayersOnServerRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPlayersOnServerRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type ListPlayersOnServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*PlayerProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Total    int64            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListPlayersOnServerResponse) Reset() {
+	*x = ListPlayersOnServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayersOnServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayersOnServerResponse) ProtoMessage() {}
+
+func (x *ListPlayersOnServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayersOnServerResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayersOnServerResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListPlayersOnServerResponse) GetProfiles() []*PlayerProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *ListPlayersOnServerResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Reconcile players with the list from proxy.
+// Only delta events (JOIN_SOLO / QUIT_SOLO / SWITCH_SOLO / UPDATE_SOLO) are published.
+// When proxyId is set, online players on the proxy missing from the list are marked as offline.
+type UpdateAllPlayersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*PlayerProfile `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	ProxyId  string           `protobuf:"bytes,2,opt,name=proxyId,proto3" json:"proxyId,omitempty"`
+}
+
+func (x *UpdateAllPlayersRequest) Reset() {
+	*x = UpdateAllPlayersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAllPlayersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAllPlayersRequest) ProtoMessage() {}
+
+func (x *UpdateAllPlayersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAllPlayersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAllPlayersRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateAllPlayersRequest) GetProfiles() []*PlayerProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *UpdateAllPlayersRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+type UpdateAllPlayersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Joined   int32 `protobuf:"varint,1,opt,name=joined,proto3" json:"joined,omitempty"`
+	Quit     int32 `protobuf:"varint,2,opt,name=quit,proto3" json:"quit,omitempty"`
+	Switched int32 `protobuf:"varint,3,opt,name=switched,proto3" json:"switched,omitempty"`
+	Updated  int32 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *UpdateAllPlayersResponse) Reset() {
+	*x = UpdateAllPlayersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAllPlayersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAllPlayersResponse) ProtoMessage() {}
+
+func (x *UpdateAllPlayersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAllPlayersResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAllPlayersResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateAllPlayersResponse) GetJoined() int32 {
+	if x != nil {
+		return x.Joined
+	}
+	return 0
+}
+
+func (x *UpdateAllPlayersResponse) GetQuit() int32 {
+	if x != nil {
+		return x.Quit
+	}
+	return 0
+}
+
+func (x *UpdateAllPlayersResponse) GetSwitched() int32 {
+	if x != nil {
+		return x.Switched
+	}
+	return 0
+}
+
+func (x *UpdateAllPlayersResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// Player Session
+type PlayerSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerUUID string `protobuf:"bytes,2,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,3,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	Server     string `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	// unix time (seconds)
+	StartedAt int64 `protobuf:"varint,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	// unix time (seconds), 0 while online
+	EndedAt int64 `protobuf:"varint,6,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	// seconds
+	Duration int64 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	// started by login (false: server switch)
+	Login bool `protobuf:"varint,8,opt,name=login,proto3" json:"login,omitempty"`
+	// ended by logout (false: server switch / still online)
+	Logout bool `protobuf:"varint,9,opt,name=logout,proto3" json:"logout,omitempty"`
+}
+
+func (x *PlayerSession) Reset() {
+	*x = PlayerSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSession) ProtoMessage() {}
+
+func (x *PlayerSession) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSession.ProtoReflect.Descriptor instead.
+func (*PlayerSession) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PlayerSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerSession) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *PlayerSession) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerSession) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *PlayerSession) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PlayerSession) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *PlayerSession) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PlayerSession) GetLogin() bool {
+	if x != nil {
+		return x.Login
+	}
+	return false
+}
+
+func (x *PlayerSession) GetLogout() bool {
+	if x != nil {
+		return x.Logout
+	}
+	return false
+}
+
+type GetPlayerSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	// default: 50, max: 500
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetPlayerSessionsRequest) Reset() {
+	*x = GetPlayerSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerSessionsRequest) ProtoMessage() {}
+
+func (x *GetPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetPlayerSessionsRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *GetPlayerSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPlayerSessionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetPlayerSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*PlayerSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *GetPlayerSessionsResponse) Reset() {
+	*x = GetPlayerSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerSessionsResponse) ProtoMessage() {}
+
+func (x *GetPlayerSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetPlayerSessionsResponse) GetSessions() []*PlayerSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetPlayerPlaytimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	// unix time (seconds), optional
+	Since int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *GetPlayerPlaytimeRequest) Reset() {
+	*x = GetPlayerPlaytimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerPlaytimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerPlaytimeRequest) ProtoMessage() {}
+
+func (x *GetPlayerPlaytimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerPlaytimeRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerPlaytimeRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetPlayerPlaytimeRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *GetPlayerPlaytimeRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetPlayerPlaytimeRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+type GetPlayerPlaytimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// seconds
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// seconds (between since and until)
+	Period int64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// unix time (seconds)
+	FirstSeen int64 `protobuf:"varint,3,opt,name=firstSeen,proto3" json:"firstSeen,omitempty"`
+	LastSeen  int64 `protobuf:"varint,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Online    bool  `protobuf:"varint,5,opt,name=online,proto3" json:"online,omitempty"`
+}
+
+func (x *GetPlayerPlaytimeResponse) Reset() {
+	*x = GetPlayerPlaytimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlayerPlaytimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerPlaytimeResponse) ProtoMessage() {}
+
+func (x *GetPlayerPlaytimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerPlaytimeResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerPlaytimeResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetPlayerPlaytimeResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPlayerPlaytimeResponse) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *GetPlayerPlaytimeResponse) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *GetPlayerPlaytimeResponse) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *GetPlayerPlaytimeResponse) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+// BroadcastStream
+type BroadcastStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broadcast *Broadcast `protobuf:"bytes,1,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	// announcementId is set when published by scheduled announcement
+	AnnouncementId int64  `protobuf:"varint,2,opt,name=announcementId,proto3" json:"announcementId,omitempty"`
+	Sequence       uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp      int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *BroadcastStream) Reset() {
+	*x = BroadcastStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastStream) ProtoMessage() {}
+
+func (x *BroadcastStream) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastStream.ProtoReflect.Descriptor instead.
+func (*BroadcastStream) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BroadcastStream) GetBroadcast() *Broadcast {
+	if x != nil {
+		return x.Broadcast
+	}
+	return nil
+}
+
+func (x *BroadcastStream) GetAnnouncementId() int64 {
+	if x != nil {
+		return x.AnnouncementId
+	}
+	return 0
+}
+
+func (x *BroadcastStream) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BroadcastStream) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type BroadcastTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type BroadcastTarget_Type `protobuf:"varint,1,opt,name=type,proto3,enum=nebulapb.BroadcastTarget_Type" json:"type,omitempty"`
+	// server name / group name (ServerEntry.group) / permission node
+	// (required except ALL)
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BroadcastTarget) Reset() {
+	*x = BroadcastTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastTarget) ProtoMessage() {}
+
+func (x *BroadcastTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastTarget.ProtoReflect.Descriptor instead.
+func (*BroadcastTarget) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *BroadcastTarget) GetType() BroadcastTarget_Type {
+	if x != nil {
+		return x.Type
+	}
+	return BroadcastTarget_ALL
+}
+
+func (x *BroadcastTarget) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type BroadcastTitle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chat component (json)
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle string `protobuf:"bytes,2,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	// ticks
+	FadeIn  int32 `protobuf:"varint,3,opt,name=fadeIn,proto3" json:"fadeIn,omitempty"`
+	Stay    int32 `protobuf:"varint,4,opt,name=stay,proto3" json:"stay,omitempty"`
+	FadeOut int32 `protobuf:"varint,5,opt,name=fadeOut,proto3" json:"fadeOut,omitempty"`
+}
+
+func (x *BroadcastTitle) Reset() {
+	*x = BroadcastTitle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastTitle) ProtoMessage() {}
+
+func (x *BroadcastTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastTitle.ProtoReflect.Descriptor instead.
+func (*BroadcastTitle) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *BroadcastTitle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BroadcastTitle) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *BroadcastTitle) GetFadeIn() int32 {
+	if x != nil {
+		return x.FadeIn
+	}
+	return 0
+}
+
+func (x *BroadcastTitle) GetStay() int32 {
+	if x != nil {
+		return x.Stay
+	}
+	return 0
+}
+
+func (x *BroadcastTitle) GetFadeOut() int32 {
+	if x != nil {
+		return x.FadeOut
+	}
+	return 0
+}
+
+type Broadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// chat component (json)
+	Message string           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Target  *BroadcastTarget `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Title   *BroadcastTitle  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// chat component (json)
+	Actionbar string `protobuf:"bytes,4,opt,name=actionbar,proto3" json:"actionbar,omitempty"`
+}
+
+func (x *Broadcast) Reset() {
+	*x = Broadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Broadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Broadcast) ProtoMessage() {}
+
+func (x *Broadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Broadcast.ProtoReflect.Descriptor instead.
+func (*Broadcast) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Broadcast) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Broadcast) GetTarget() *BroadcastTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Broadcast) GetTitle() *BroadcastTitle {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *Broadcast) GetActionbar() string {
+	if x != nil {
+		return x.Actionbar
+	}
+	return ""
+}
+
+type Announcement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Broadcast *Broadcast `protobuf:"bytes,2,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	// seconds
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// always true when added by AddAnnouncement
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// unix time (seconds)
+	NextRunAt int64 `protobuf:"varint,5,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`
+}
+
+func (x *Announcement) Reset() {
+	*x = Announcement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcement) ProtoMessage() {}
+
+func (x *Announcement) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcement.ProtoReflect.Descriptor instead.
+func (*Announcement) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Announcement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Announcement) GetBroadcast() *Broadcast {
+	if x != nil {
+		return x.Broadcast
+	}
+	return nil
+}
+
+func (x *Announcement) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Announcement) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Announcement) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+type BroadcastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broadcast *Broadcast `protobuf:"bytes,1,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+}
+
+func (x *BroadcastRequest) Reset() {
+	*x = BroadcastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastRequest) ProtoMessage() {}
+
+func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BroadcastRequest) GetBroadcast() *Broadcast {
+	if x != nil {
+		return x.Broadcast
+	}
+	return nil
+}
+
+type BroadcastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BroadcastResponse) Reset() {
+	*x = BroadcastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastResponse) ProtoMessage() {}
+
+func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{101}
+}
+
+type GetAnnouncementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAnnouncementsRequest) Reset() {
+	*x = GetAnnouncementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsRequest) ProtoMessage() {}
+
+func (x *GetAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{102}
+}
+
+type GetAnnouncementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcements []*Announcement `protobuf:"bytes,1,rep,name=announcements,proto3" json:"announcements,omitempty"`
+}
+
+func (x *GetAnnouncementsResponse) Reset() {
+	*x = GetAnnouncementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnnouncementsResponse) ProtoMessage() {}
+
+func (x *GetAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetAnnouncementsResponse) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+type AddAnnouncementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+}
+
+func (x *AddAnnouncementRequest) Reset() {
+	*x = AddAnnouncementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementRequest) ProtoMessage() {}
+
+func (x *AddAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AddAnnouncementRequest) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type AddAnnouncementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcement *Announcement `protobuf:"bytes,1,opt,name=announcement,proto3" json:"announcement,omitempty"`
+}
+
+func (x *AddAnnouncementResponse) Reset() {
+	*x = AddAnnouncementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAnnouncementResponse) ProtoMessage() {}
+
+func (x *AddAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*AddAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AddAnnouncementResponse) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+type RemoveAnnouncementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveAnnouncementRequest) Reset() {
+	*x = RemoveAnnouncementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAnnouncementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAnnouncementRequest) ProtoMessage() {}
+
+func (x *RemoveAnnouncementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAnnouncementRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RemoveAnnouncementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveAnnouncementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveAnnouncementResponse) Reset() {
+	*x = RemoveAnnouncementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAnnouncementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAnnouncementResponse) ProtoMessage() {}
+
+func (x *RemoveAnnouncementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAnnouncementResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{107}
+}
+
+// Punishment
+type Punishment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       Punishment_Type `protobuf:"varint,2,opt,name=type,proto3,enum=nebulapb.Punishment_Type" json:"type,omitempty"`
+	PlayerUUID string          `protobuf:"bytes,3,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string          `protobuf:"bytes,4,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	// IPBAN: IP address or CIDR
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorUUID string `protobuf:"bytes,7,opt,name=actorUUID,proto3" json:"actorUUID,omitempty"`
+	ActorName string `protobuf:"bytes,8,opt,name=actorName,proto3" json:"actorName,omitempty"`
+	// unix time (seconds)
+	CreatedAt int64 `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// unix time (0: permanent, required for TEMPBAN)
+	ExpiresAt    int64  `protobuf:"varint,10,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Revoked      bool   `protobuf:"varint,11,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedAt    int64  `protobuf:"varint,12,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+	RevokedBy    string `protobuf:"bytes,13,opt,name=revokedBy,proto3" json:"revokedBy,omitempty"`
+	RevokeReason string `protobuf:"bytes,14,opt,name=revokeReason,proto3" json:"revokeReason,omitempty"`
+	// not revoked and not expired
+	Active bool `protobuf:"varint,15,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *Punishment) Reset() {
+	*x = Punishment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Punishment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Punishment) ProtoMessage() {}
+
+func (x *Punishment) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Punishment.ProtoReflect.Descriptor instead.
+func (*Punishment) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Punishment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Punishment) GetType() Punishment_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Punishment_BAN
+}
+
+func (x *Punishment) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *Punishment) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *Punishment) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Punishment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Punishment) GetActorUUID() string {
+	if x != nil {
+		return x.ActorUUID
+	}
+	return ""
+}
+
+func (x *Punishment) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *Punishment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Punishment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Punishment) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *Punishment) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+func (x *Punishment) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+func (x *Punishment) GetRevokeReason() string {
+	if x != nil {
+		return x.RevokeReason
+	}
+	return ""
+}
+
+func (x *Punishment) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PunishRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Punishment *Punishment `protobuf:"bytes,1,opt,name=punishment,proto3" json:"punishment,omitempty"`
+}
+
+func (x *PunishRequest) Reset() {
+	*x = PunishRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PunishRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunishRequest) ProtoMessage() {}
+
+func (x *PunishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunishRequest.ProtoReflect.Descriptor instead.
+func (*PunishRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PunishRequest) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+type PunishResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Punishment *Punishment `protobuf:"bytes,1,opt,name=punishment,proto3" json:"punishment,omitempty"`
+	// players kicked by the punishment
+	Kicked []string `protobuf:"bytes,2,rep,name=kicked,proto3" json:"kicked,omitempty"`
+}
+
+func (x *PunishResponse) Reset() {
+	*x = PunishResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PunishResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunishResponse) ProtoMessage() {}
+
+func (x *PunishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunishResponse.ProtoReflect.Descriptor instead.
+func (*PunishResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PunishResponse) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+func (x *PunishResponse) GetKicked() []string {
+	if x != nil {
+		return x.Kicked
+	}
+	return nil
+}
+
+type RevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorUUID string `protobuf:"bytes,2,opt,name=actorUUID,proto3" json:"actorUUID,omitempty"`
+	ActorName string `protobuf:"bytes,3,opt,name=actorName,proto3" json:"actorName,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RevokeRequest) Reset() {
+	*x = RevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRequest) ProtoMessage() {}
+
+func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RevokeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeRequest) GetActorUUID() string {
+	if x != nil {
+		return x.ActorUUID
+	}
+	return ""
+}
+
+func (x *RevokeRequest) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *RevokeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Punishment *Punishment `protobuf:"bytes,1,opt,name=punishment,proto3" json:"punishment,omitempty"`
+}
+
+func (x *RevokeResponse) Reset() {
+	*x = RevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeResponse) ProtoMessage() {}
+
+func (x *RevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RevokeResponse) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+type GetPunishmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	// IPBAN by address
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	IncludeInactive bool   `protobuf:"varint,3,opt,name=includeInactive,proto3" json:"includeInactive,omitempty"`
+	// default: 50, max: 500
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetPunishmentsRequest) Reset() {
+	*x = GetPunishmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPunishmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPunishmentsRequest) ProtoMessage() {}
+
+func (x *GetPunishmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPunishmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetPunishmentsRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetPunishmentsRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *GetPunishmentsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetPunishmentsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *GetPunishmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPunishmentsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetPunishmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Punishments []*Punishment `protobuf:"bytes,1,rep,name=punishments,proto3" json:"punishments,omitempty"`
+}
+
+func (x *GetPunishmentsResponse) Reset() {
+	*x = GetPunishmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPunishmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPunishmentsResponse) ProtoMessage() {}
+
+func (x *GetPunishmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPunishmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetPunishmentsResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetPunishmentsResponse) GetPunishments() []*Punishment {
+	if x != nil {
+		return x.Punishments
+	}
+	return nil
+}
+
+type CheckLoginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerUUID string `protobuf:"bytes,1,opt,name=playerUUID,proto3" json:"playerUUID,omitempty"`
+	PlayerName string `protobuf:"bytes,2,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	IpAddress  string `protobuf:"bytes,3,opt,name=ipAddress,proto3" json:"ipAddress,omitempty"`
+}
+
+func (x *CheckLoginRequest) Reset() {
+	*x = CheckLoginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLoginRequest) ProtoMessage() {}
+
+func (x *CheckLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLoginRequest.ProtoReflect.Descriptor instead.
+func (*CheckLoginRequest) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CheckLoginRequest) GetPlayerUUID() string {
+	if x != nil {
+		return x.PlayerUUID
+	}
+	return ""
+}
+
+func (x *CheckLoginRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CheckLoginRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type CheckLoginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// BANNED, IP_BANNED, NOT_WHITELISTED
+	Reason     string      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Punishment *Punishment `protobuf:"bytes,3,opt,name=punishment,proto3" json:"punishment,omitempty"`
+}
+
+func (x *CheckLoginResponse) Reset() {
+	*x = CheckLoginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLoginResponse) ProtoMessage() {}
+
+func (x *CheckLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLoginResponse.ProtoReflect.Descriptor instead.
+func (*CheckLoginResponse) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CheckLoginResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckLoginResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckLoginResponse) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+type ServerStatus_Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Protocol int32  `protobuf:"varint,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+}
+
+func (x *ServerStatus_Version) Reset() {
+	*x = ServerStatus_Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStatus_Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatus_Version) ProtoMessage() {}
+
+func (x *ServerStatus_Version) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatus_Version.ProtoReflect.Descriptor instead.
+func (*ServerStatus_Version) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ServerStatus_Version) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerStatus_Version) GetProtocol() int32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+type ServerStatus_Players struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Max    int32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty"`
+	Online int32 `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"` // should be have sample?
+}
+
+func (x *ServerStatus_Players) Reset() {
+	*x = ServerStatus_Players{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nebulapb_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerStatus_Players) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatus_Players) ProtoMessage() {}
+
+func (x *ServerStatus_Players) ProtoReflect() protoreflect.Message {
+	mi := &file_nebulapb_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatus_Players.ProtoReflect.Descriptor instead.
+func (*ServerStatus_Players) Descriptor() ([]byte, []int) {
+	return file_nebulapb_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *ServerStatus_Players) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ServerStatus_Players) GetOnline() int32 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+var File_nebulapb_proto protoreflect.FileDescriptor
+
+var file_nebulapb_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x22, 0xa0, 0x03, 0x0a, 0x0b, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x67, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8c, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xfc, 0x02,
+	0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x6f,
+	0x12, 0x29, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x22, 0x59, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4a, 0x4f, 0x49,
+	0x4e, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x51, 0x55, 0x49, 0x54,
+	0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x44, 0x56, 0x45, 0x52,
+	0x54, 0x49, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x57,
+	0x49, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x04, 0x22, 0xf8, 0x02, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x4b, 0x49, 0x43, 0x4b, 0x10, 0x02, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x1e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x4e,
+	0x49, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x10,
+	0x01, 0x22, 0xea, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x1c, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x97,
+	0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x1a, 0x39, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x33, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x15, 0x41, 0x64, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x02, 0x0a, 0x11, 0x42, 0x75, 0x6e, 0x67, 0x65,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e,
+	0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x1d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x59,
+	0x0a, 0x0b, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x74,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
+	0x1b, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
+	0x74, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69,
+	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61,
+	0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x76,
+	0x69, 0x63, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69, 0x63,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x47, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x54, 0x6f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x48, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x5c,
+	0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x58,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xd6, 0x01, 0x0a,
+	0x0e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69,
+	0x6f, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x0f, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xd0, 0x01, 0x0a,
+	0x0d, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x1d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x22,
+	0x43, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x31, 0x0a, 0x15, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x18, 0x0a,
+	0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4a, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75,
+	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x16, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55,
+	0x55, 0x49, 0x44, 0x22, 0x6d, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd2, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x69, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x58, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe9, 0x01, 0x0a,
+	0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x68, 0x69, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x22, 0x7f, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x19, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x1a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x22, 0x4e, 0x0a, 0x17, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x46,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x79, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x49, 0x64, 0x22, 0xda, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22,
+	0x4e, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0xd1, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6d,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0x69, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75,
+	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x22, 0x4b, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0x53, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x73,
+	0x22, 0x6a, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xa0, 0x01, 0x0a,
+	0x13, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55,
+	0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22,
+	0x50, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x22, 0x4c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0x5e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22,
+	0x4c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x84, 0x01,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x68,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x71, 0x75, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x71, 0x75, 0x69, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xf9, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x67, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x22, 0x68, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x66,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0f, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x93, 0x01,
+	0x0a, 0x0f, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x03, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x64, 0x65,
+	0x49, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x64, 0x65, 0x49, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x74, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x61, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x22, 0xa6,
+	0x01, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x62, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x61, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x22,
+	0x45, 0x0a, 0x10, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09, 0x62, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x62, 0x75,
+	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x54, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0c, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a,
+	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x04, 0x0a, 0x0a, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x4d, 0x50, 0x42, 0x41, 0x4e, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41,
+	0x52, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x50, 0x42, 0x41, 0x4e, 0x10, 0x04, 0x22,
+	0x45, 0x0a, 0x0d, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x0e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x71, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x7c, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a,
+	0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x70, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x32, 0xc2, 0x1f, 0x0a, 0x06, 0x4e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x12, 0x55, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x67,
+	0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e,
+	0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67, 0x65,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x12, 0x18, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69, 0x63,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x46, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x1d, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x6f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x54, 0x6f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x24,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08,
+	0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49,
+	0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x49,
+	0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x21,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x50, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x69, 0x74, 0x12,
+	0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x48, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x46,
+	0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75,
+	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25,
+	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6e, 0x65,
+	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e,
+	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
+	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x50, 0x75, 0x6e, 0x69, 0x73,
+	0x68, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x12, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x62, 0x75,
+	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x69,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
+	0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6e, 0x69, 0x73, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62,
+	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x34, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x79, 0x6e, 0x63, 0x68, 0x74, 0x68, 0x69, 0x61, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x2e,
+	0x61, 0x70, 0x69, 0x42, 0x0c, 0x4e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x5a, 0x0a, 0x2e, 0x2f, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_nebulapb_proto_rawDescOnce sync.Once
+	file_nebulapb_proto_rawDescData = file_nebulapb_proto_rawDesc
+)
+
+func file_nebulapb_proto_rawDescGZIP() []byte {
+	file_nebulapb_proto_rawDescOnce.Do(func() {
+		file_nebulapb_proto_rawDescData = protoimpl.X.CompressGZIP(file_nebulapb_proto_rawDescData)
+	})
+	return file_nebulapb_proto_rawDescData
+}
+
+var file_nebulapb_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_nebulapb_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
+var file_nebulapb_proto_goTypes = []interface{}{
+	(PlayerPropertiesStream_Type)(0),     // 0: nebulapb.PlayerPropertiesStream.Type
+	(ProxyStream_Type)(0),                // 1: nebulapb.ProxyStream.Type
+	(PunishmentStream_Type)(0),           // 2: nebulapb.PunishmentStream.Type
+	(ServerEntryStream_Type)(0),          // 3: nebulapb.ServerEntryStream.Type
+	(BungeeEntryStream_Type)(0),          // 4: nebulapb.BungeeEntryStream.Type
+	(IPFilterEntry_Action)(0),            // 5: nebulapb.IPFilterEntry.Action
+	(BroadcastTarget_Type)(0),            // 6: nebulapb.BroadcastTarget.Type
+	(Punishment_Type)(0),                 // 7: nebulapb.Punishment.Type
+	(*StreamEvent)(nil),                  // 8: nebulapb.StreamEvent
+	(*GetChangesSinceRequest)(nil),       // 9: nebulapb.GetChangesSinceRequest
+	(*GetChangesSinceResponse)(nil),      // 10: nebulapb.GetChangesSinceResponse
+	(*PlayerPropertiesStream)(nil),       // 11: nebulapb.PlayerPropertiesStream
+	(*ProxyStream)(nil),                  // 12: nebulapb.ProxyStream
+	(*PunishmentStream)(nil),             // 13: nebulapb.PunishmentStream
+	(*ServerEntryStream)(nil),            // 14: nebulapb.ServerEntryStream
+	(*ServerEntry)(nil),                  // 15: nebulapb.ServerEntry
+	(*Lockdown)(nil),                     // 16: nebulapb.Lockdown
+	(*ServerStatus)(nil),                 // 17: nebulapb.ServerStatus
+	(*GetServerEntryRequest)(nil),        // 18: nebulapb.GetServerEntryRequest
+	(*GetServerEntryResponse)(nil),       // 19: nebulapb.GetServerEntryResponse
+	(*AddServerEntryRequest)(nil),        // 20: nebulapb.AddServerEntryRequest
+	(*AddServerEntryResponse)(nil),       // 21: nebulapb.AddServerEntryResponse
+	(*RemoveServerEntryRequest)(nil),     // 22: nebulapb.RemoveServerEntryRequest
+	(*RemoveServerEntryResponse)(nil),    // 23: nebulapb.RemoveServerEntryResponse
+	(*BungeeEntryStream)(nil),            // 24: nebulapb.BungeeEntryStream
+	(*BungeeEntry)(nil),                  // 25: nebulapb.BungeeEntry
+	(*GetBungeeEntryRequest)(nil),        // 26: nebulapb.GetBungeeEntryRequest
+	(*GetBungeeEntryResponse)(nil),       // 27: nebulapb.GetBungeeEntryResponse
+	(*SendBungeeCommandRequest)(nil),     // 28: nebulapb.SendBungeeCommandRequest
+	(*SendBungeeCommandResponse)(nil),    // 29: nebulapb.SendBungeeCommandResponse
+	(*SetMotdRequest)(nil),               // 30: nebulapb.SetMotdRequest
+	(*SetMotdResponse)(nil),              // 31: nebulapb.SetMotdResponse
+	(*SetFaviconRequest)(nil),            // 32: nebulapb.SetFaviconRequest
+	(*SetFaviconResponse)(nil),           // 33: nebulapb.SetFaviconResponse
+	(*WhitelistEntry)(nil),               // 34: nebulapb.WhitelistEntry
+	(*SetWhitelistRequest)(nil),          // 35: nebulapb.SetWhitelistRequest
+	(*SetWhitelistResponse)(nil),         // 36: nebulapb.SetWhitelistResponse
+	(*AddToWhitelistRequest)(nil),        // 37: nebulapb.AddToWhitelistRequest
+	(*AddToWhitelistResponse)(nil),       // 38: nebulapb.AddToWhitelistResponse
+	(*RemoveFromWhitelistRequest)(nil),   // 39: nebulapb.RemoveFromWhitelistRequest
+	(*RemoveFromWhitelistResponse)(nil),  // 40: nebulapb.RemoveFromWhitelistResponse
+	(*GetWhitelistRequest)(nil),          // 41: nebulapb.GetWhitelistRequest
+	(*GetWhitelistResponse)(nil),         // 42: nebulapb.GetWhitelistResponse
+	(*SetLockdownRequest)(nil),           // 43: nebulapb.SetLockdownRequest
+	(*SetLockdownResponse)(nil),          // 44: nebulapb.SetLockdownResponse
+	(*IPLookupResult)(nil),               // 45: nebulapb.IPLookupResult
+	(*IPLookupRequest)(nil),              // 46: nebulapb.IPLookupRequest
+	(*IPLookupResponse)(nil),             // 47: nebulapb.IPLookupResponse
+	(*IPFilterEntry)(nil),                // 48: nebulapb.IPFilterEntry
+	(*AddIPFilterRequest)(nil),           // 49: nebulapb.AddIPFilterRequest
+	(*AddIPFilterResponse)(nil),          // 50: nebulapb.AddIPFilterResponse
+	(*RemoveIPFilterRequest)(nil),        // 51: nebulapb.RemoveIPFilterRequest
+	(*RemoveIPFilterResponse)(nil),       // 52: nebulapb.RemoveIPFilterResponse
+	(*ListIPFiltersRequest)(nil),         // 53: nebulapb.ListIPFiltersRequest
+	(*ListIPFiltersResponse)(nil),        // 54: nebulapb.ListIPFiltersResponse
+	(*CheckConnectionRequest)(nil),       // 55: nebulapb.CheckConnectionRequest
+	(*CheckConnectionResponse)(nil),      // 56: nebulapb.CheckConnectionResponse
+	(*GetIPLookupStatsRequest)(nil),      // 57: nebulapb.GetIPLookupStatsRequest
+	(*GetIPLookupStatsResponse)(nil),     // 58: nebulapb.GetIPLookupStatsResponse
+	(*PlayerProperty)(nil),               // 59: nebulapb.PlayerProperty
+	(*PlayerProfile)(nil),                // 60: nebulapb.PlayerProfile
+	(*ProxyHeartbeatRequest)(nil),        // 61: nebulapb.ProxyHeartbeatRequest
+	(*ProxyHeartbeatResponse)(nil),       // 62: nebulapb.ProxyHeartbeatResponse
+	(*ResetProxyRequest)(nil),            // 63: nebulapb.ResetProxyRequest
+	(*ResetProxyResponse)(nil),           // 64: nebulapb.ResetProxyResponse
+	(*PlayerLoginRequest)(nil),           // 65: nebulapb.PlayerLoginRequest
+	(*PlayerLoginResponse)(nil),          // 66: nebulapb.PlayerLoginResponse
+	(*PlayerQuitRequest)(nil),            // 67: nebulapb.PlayerQuitRequest
+	(*PlayerQuitResponse)(nil),           // 68: nebulapb.PlayerQuitResponse
+	(*PlayerSwitchServerRequest)(nil),    // 69: nebulapb.PlayerSwitchServerRequest
+	(*PlayerSwitchServerResponse)(nil),   // 70: nebulapb.PlayerSwitchServerResponse
+	(*FetchAllPlayersRequest)(nil),       // 71: nebulapb.FetchAllPlayersRequest
+	(*FetchAllPlayersResponse)(nil),      // 72: nebulapb.FetchAllPlayersResponse
+	(*GetPlayerRequest)(nil),             // 73: nebulapb.GetPlayerRequest
+	(*GetPlayerResponse)(nil),            // 74: nebulapb.GetPlayerResponse
+	(*FindPlayerRequest)(nil),            // 75: nebulapb.FindPlayerRequest
+	(*FindPlayerResponse)(nil),           // 76: nebulapb.FindPlayerResponse
+	(*SendPlayerMessageRequest)(nil),     // 77: nebulapb.SendPlayerMessageRequest
+	(*SendPlayerMessageResponse)(nil),    // 78: nebulapb.SendPlayerMessageResponse
+	(*PlayerAddress)(nil),                // 79: nebulapb.PlayerAddress
+	(*GetAccountsByAddressRequest)(nil),  // 80: nebulapb.GetAccountsByAddressRequest
+	(*GetAccountsByAddressResponse)(nil), // 81: nebulapb.GetAccountsByAddressResponse
+	(*GetPlayerAddressesRequest)(nil),    // 82: nebulapb.GetPlayerAddressesRequest
+	(*GetPlayerAddressesResponse)(nil),   // 83: nebulapb.GetPlayerAddressesResponse
+	(*TransferPlayerRequest)(nil),        // 84: nebulapb.TransferPlayerRequest
+	(*TransferPlayerResponse)(nil),       // 85: nebulapb.TransferPlayerResponse
+	(*TransferServerRequest)(nil),        // 86: nebulapb.TransferServerRequest
+	(*TransferServerResponse)(nil),       // 87: nebulapb.TransferServerResponse
+	(*DrainServerRequest)(nil),           // 88: nebulapb.DrainServerRequest
+	(*DrainServerResponse)(nil),          // 89: nebulapb.DrainServerResponse
+	(*SetPlayerHiddenRequest)(nil),       // 90: nebulapb.SetPlayerHiddenRequest
+	(*SetPlayerHiddenResponse)(nil),      // 91: nebulapb.SetPlayerHiddenResponse
+	(*GetPlayerByNameRequest)(nil),       // 92: nebulapb.GetPlayerByNameRequest
+	(*GetPlayerByNameResponse)(nil),      // 93: nebulapb.GetPlayerByNameResponse
+	(*ListPlayersOnServerRequest)(nil),   // 94: nebulapb.ListPlayersOnServerRequest
+	(*ListPlayersOnServerResponse)(nil),  // 95: nebulapb.ListPlayersOnServerResponse
+	(*UpdateAllPlayersRequest)(nil),      // 96: nebulapb.UpdateAllPlayersRequest
+	(*UpdateAllPlayersResponse)(nil),     // 97: nebulapb.UpdateAllPlayersResponse
+	(*PlayerSession)(nil),                // 98: nebulapb.PlayerSession
+	(*GetPlayerSessionsRequest)(nil),     // 99: nebulapb.GetPlayerSessionsRequest
+	(*GetPlayerSessionsResponse)(nil),    // 100: nebulapb.GetPlayerSessionsResponse
+	(*GetPlayerPlaytimeRequest)(nil),     // 101: nebulapb.GetPlayerPlaytimeRequest
+	(*GetPlayerPlaytimeResponse)(nil),    // 102: nebulapb.GetPlayerPlaytimeResponse
+	(*BroadcastStream)(nil),              // 103: nebulapb.BroadcastStream
+	(*BroadcastTarget)(nil),              // 104: nebulapb.BroadcastTarget
+	(*BroadcastTitle)(nil),               // 105: nebulapb.BroadcastTitle
+	(*Broadcast)(nil),                    // 106: nebulapb.Broadcast
+	(*Announcement)(nil),                 // 107: nebulapb.Announcement
+	(*BroadcastRequest)(nil),             // 108: nebulapb.BroadcastRequest
+	(*BroadcastResponse)(nil),            // 109: nebulapb.BroadcastResponse
+	(*GetAnnouncementsRequest)(nil),      // 110: nebulapb.GetAnnouncementsRequest
+	(*GetAnnouncementsResponse)(nil),     // 111: nebulapb.GetAnnouncementsResponse
+	(*AddAnnouncementRequest)(nil),       // 112: nebulapb.AddAnnouncementRequest
+	(*AddAnnouncementResponse)(nil),      // 113: nebulapb.AddAnnouncementResponse
+	(*RemoveAnnouncementRequest)(nil),    // 114: nebulapb.RemoveAnnouncementRequest
+	(*RemoveAnnouncementResponse)(nil),   // 115: nebulapb.RemoveAnnouncementResponse
+	(*Punishment)(nil),                   // 116: nebulapb.Punishment
+	(*PunishRequest)(nil),                // 117: nebulapb.PunishRequest
+	(*PunishResponse)(nil),               // 118: nebulapb.PunishResponse
+	(*RevokeRequest)(nil),                // 119: nebulapb.RevokeRequest
+	(*RevokeResponse)(nil),               // 120: nebulapb.RevokeResponse
+	(*GetPunishmentsRequest)(nil),        // 121: nebulapb.GetPunishmentsRequest
+	(*GetPunishmentsResponse)(nil),       // 122: nebulapb.GetPunishmentsResponse
+	(*CheckLoginRequest)(nil),            // 123: nebulapb.CheckLoginRequest
+	(*CheckLoginResponse)(nil),           // 124: nebulapb.CheckLoginResponse
+	(*ServerStatus_Version)(nil),         // 125: nebulapb.ServerStatus.Version
+	(*ServerStatus_Players)(nil),         // 126: nebulapb.ServerStatus.Players
+}
+var file_nebulapb_proto_depIdxs = []int32{
+	14,  // 0: nebulapb.StreamEvent.server:type_name -> nebulapb.ServerEntryStream
+	24,  // 1: nebulapb.StreamEvent.bungee:type_name -> nebulapb.BungeeEntryStream
+	11,  // 2: nebulapb.StreamEvent.player:type_name -> nebulapb.PlayerPropertiesStream
+	103, // 3: nebulapb.StreamEvent.broadcast:type_name -> nebulapb.BroadcastStream
+	12,  // 4: nebulapb.StreamEvent.proxy:type_name -> nebulapb.ProxyStream
+	13,  // 5: nebulapb.StreamEvent.punishment:type_name -> nebulapb.PunishmentStream
+	8,   // 6: nebulapb.GetChangesSinceResponse.events:type_name -> nebulapb.StreamEvent
+	0,   // 7: nebulapb.PlayerPropertiesStream.type:type_name -> nebulapb.PlayerPropertiesStream.Type
+	60,  // 8: nebulapb.PlayerPropertiesStream.solo:type_name -> nebulapb.PlayerProfile
+	60,  // 9: nebulapb.PlayerPropertiesStream.all:type_name -> nebulapb.PlayerProfile
+	1,   // 10: nebulapb.ProxyStream.type:type_name -> nebulapb.ProxyStream.Type
+	116, // 11: nebulapb.ProxyStream.punishment:type_name -> nebulapb.Punishment
+	2,   // 12: nebulapb.PunishmentStream.type:type_name -> nebulapb.PunishmentStream.Type
+	116, // 13: nebulapb.PunishmentStream.punishment:type_name -> nebulapb.Punishment
+	3,   // 14: nebulapb.ServerEntryStream.type:type_name -> nebulapb.ServerEntryStream.Type
+	15,  // 15: nebulapb.ServerEntryStream.entry:type_name -> nebulapb.ServerEntry
+	16,  // 16: nebulapb.ServerEntry.lockdown:type_name -> nebulapb.Lockdown
+	17,  // 17: nebulapb.ServerEntry.status:type_name -> nebulapb.ServerStatus
+	125, // 18: nebulapb.ServerStatus.version:type_name -> nebulapb.ServerStatus.Version
+	126, // 19: nebulapb.ServerStatus.players:type_name -> nebulapb.ServerStatus.Players
+	15,  // 20: nebulapb.GetServerEntryResponse.entry:type_name -> nebulapb.ServerEntry
+	15,  // 21: nebulapb.AddServerEntryRequest.entry:type_name -> nebulapb.ServerEntry
+	4,   // 22: nebulapb.BungeeEntryStream.type:type_name -> nebulapb.BungeeEntryStream.Type
+	25,  // 23: nebulapb.BungeeEntryStream.entry:type_name -> nebulapb.BungeeEntry
+	25,  // 24: nebulapb.GetBungeeEntryResponse.entry:type_name -> nebulapb.BungeeEntry
+	25,  // 25: nebulapb.SetWhitelistResponse.entry:type_name -> nebulapb.BungeeEntry
+	34,  // 26: nebulapb.AddToWhitelistRequest.entry:type_name -> nebulapb.WhitelistEntry
+	34,  // 27: nebulapb.AddToWhitelistResponse.entry:type_name -> nebulapb.WhitelistEntry
+	34,  // 28: nebulapb.GetWhitelistResponse.entries:type_name -> nebulapb.WhitelistEntry
+	16,  // 29: nebulapb.SetLockdownRequest.lockdown:type_name -> nebulapb.Lockdown
+	15,  // 30: nebulapb.SetLockdownResponse.entry:type_name -> nebulapb.ServerEntry
+	45,  // 31: nebulapb.IPLookupResponse.result:type_name -> nebulapb.IPLookupResult
+	5,   // 32: nebulapb.IPFilterEntry.action:type_name -> nebulapb.IPFilterEntry.Action
+	48,  // 33: nebulapb.AddIPFilterRequest.entry:type_name -> nebulapb.IPFilterEntry
+	48,  // 34: nebulapb.AddIPFilterResponse.entry:type_name -> nebulapb.IPFilterEntry
+	48,  // 35: nebulapb.ListIPFiltersResponse.entries:type_name -> nebulapb.IPFilterEntry
+	59,  // 36: nebulapb.PlayerProfile.properties:type_name -> nebulapb.PlayerProperty
+	60,  // 37: nebulapb.PlayerLoginRequest.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 38: nebulapb.PlayerQuitRequest.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 39: nebulapb.PlayerSwitchServerResponse.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 40: nebulapb.FetchAllPlayersResponse.profiles:type_name -> nebulapb.PlayerProfile
+	60,  // 41: nebulapb.GetPlayerResponse.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 42: nebulapb.FindPlayerResponse.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 43: nebulapb.SendPlayerMessageResponse.profile:type_name -> nebulapb.PlayerProfile
+	79,  // 44: nebulapb.GetAccountsByAddressResponse.addresses:type_name -> nebulapb.PlayerAddress
+	79,  // 45: nebulapb.GetPlayerAddressesResponse.addresses:type_name -> nebulapb.PlayerAddress
+	60,  // 46: nebulapb.TransferPlayerResponse.profile:type_name -> nebulapb.PlayerProfile
+	15,  // 47: nebulapb.DrainServerResponse.entry:type_name -> nebulapb.ServerEntry
+	60,  // 48: nebulapb.SetPlayerHiddenResponse.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 49: nebulapb.GetPlayerByNameResponse.profile:type_name -> nebulapb.PlayerProfile
+	60,  // 50: nebulapb.ListPlayersOnServerResponse.profiles:type_name -> nebulapb.PlayerProfile
+	60,  // 51: nebulapb.UpdateAllPlayersRequest.profiles:type_name -> nebulapb.PlayerProfile
+	98,  // 52: nebulapb.GetPlayerSessionsResponse.sessions:type_name -> nebulapb.PlayerSession
+	106, // 53: nebulapb.BroadcastStream.broadcast:type_name -> nebulapb.Broadcast
+	6,   // 54: nebulapb.BroadcastTarget.type:type_name -> nebulapb.BroadcastTarget.Type
+	104, // 55: nebulapb.Broadcast.target:type_name -> nebulapb.BroadcastTarget
+	105, // 56: nebulapb.Broadcast.title:type_name -> nebulapb.BroadcastTitle
+	106, // 57: nebulapb.Announcement.broadcast:type_name -> nebulapb.Broadcast
+	106, // 58: nebulapb.BroadcastRequest.broadcast:type_name -> nebulapb.Broadcast
+	107, // 59: nebulapb.GetAnnouncementsResponse.announcements:type_name -> nebulapb.Announcement
+	107, // 60: nebulapb.AddAnnouncementRequest.announcement:type_name -> nebulapb.Announcement
+	107, // 61: nebulapb.AddAnnouncementResponse.announcement:type_name -> nebulapb.Announcement
+	7,   // 62: nebulapb.Punishment.type:type_name -> nebulapb.Punishment.Type
+	116, // 63: nebulapb.PunishRequest.punishment:type_name -> nebulapb.Punishment
+	116, // 64: nebulapb.PunishResponse.punishment:type_name -> nebulapb.Punishment
+	116, // 65: nebulapb.RevokeResponse.punishment:type_name -> nebulapb.Punishment
+	116, // 66: nebulapb.GetPunishmentsResponse.punishments:type_name -> nebulapb.Punishment
+	116, // 67: nebulapb.CheckLoginResponse.punishment:type_name -> nebulapb.Punishment
+	18,  // 68: nebulapb.Nebula.GetServerEntry:input_type -> nebulapb.GetServerEntryRequest
+	20,  // 69: nebulapb.Nebula.AddServerEntry:input_type -> nebulapb.AddServerEntryRequest
+	22,  // 70: nebulapb.Nebula.RemoveServerEntry:input_type -> nebulapb.RemoveServerEntryRequest
+	26,  // 71: nebulapb.Nebula.GetBungeeEntry:input_type -> nebulapb.GetBungeeEntryRequest
+	28,  // 72: nebulapb.Nebula.SendBungeeCommand:input_type -> nebulapb.SendBungeeCommandRequest
+	30,  // 73: nebulapb.Nebula.SetMotd:input_type -> nebulapb.SetMotdRequest
+	32,  // 74: nebulapb.Nebula.SetFavicon:input_type -> nebulapb.SetFaviconRequest
+	35,  // 75: nebulapb.Nebula.SetWhitelist:input_type -> nebulapb.SetWhitelistRequest
+	37,  // 76: nebulapb.Nebula.AddToWhitelist:input_type -> nebulapb.AddToWhitelistRequest
+	39,  // 77: nebulapb.Nebula.RemoveFromWhitelist:input_type -> nebulapb.RemoveFromWhitelistRequest
+	41,  // 78: nebulapb.Nebula.GetWhitelist:input_type -> nebulapb.GetWhitelistRequest
+	43,  // 79: nebulapb.Nebula.SetLockdown:input_type -> nebulapb.SetLockdownRequest
+	46,  // 80: nebulapb.Nebula.IPLookup:input_type -> nebulapb.IPLookupRequest
+	49,  // 81: nebulapb.Nebula.AddIPFilter:input_type -> nebulapb.AddIPFilterRequest
+	51,  // 82: nebulapb.Nebula.RemoveIPFilter:input_type -> nebulapb.RemoveIPFilterRequest
+	53,  // 83: nebulapb.Nebula.ListIPFilters:input_type -> nebulapb.ListIPFiltersRequest
+	57,  // 84: nebulapb.Nebula.GetIPLookupStats:input_type -> nebulapb.GetIPLookupStatsRequest
+	55,  // 85: nebulapb.Nebula.CheckConnection:input_type -> nebulapb.CheckConnectionRequest
+	61,  // 86: nebulapb.Nebula.ProxyHeartbeat:input_type -> nebulapb.ProxyHeartbeatRequest
+	63,  // 87: nebulapb.Nebula.ResetProxy:input_type -> nebulapb.ResetProxyRequest
+	65,  // 88: nebulapb.Nebula.PlayerLogin:input_type -> nebulapb.PlayerLoginRequest
+	67,  // 89: nebulapb.Nebula.PlayerQuit:input_type -> nebulapb.PlayerQuitRequest
+	69,  // 90: nebulapb.Nebula.PlayerSwitchServer:input_type -> nebulapb.PlayerSwitchServerRequest
+	71,  // 91: nebulapb.Nebula.FetchAllPlayers:input_type -> nebulapb.FetchAllPlayersRequest
+	73,  // 92: nebulapb.Nebula.GetPlayer:input_type -> nebulapb.GetPlayerRequest
+	90,  // 93: nebulapb.Nebula.SetPlayerHidden:input_type -> nebulapb.SetPlayerHiddenRequest
+	92,  // 94: nebulapb.Nebula.GetPlayerByName:input_type -> nebulapb.GetPlayerByNameRequest
+	94,  // 95: nebulapb.Nebula.ListPlayersOnServer:input_type -> nebulapb.ListPlayersOnServerRequest
+	75,  // 96: nebulapb.Nebula.FindPlayer:input_type -> nebulapb.FindPlayerRequest
+	77,  // 97: nebulapb.Nebula.SendPlayerMessage:input_type -> nebulapb.SendPlayerMessageRequest
+	80,  // 98: nebulapb.Nebula.GetAccountsByAddress:input_type -> nebulapb.GetAccountsByAddressRequest
+	82,  // 99: nebulapb.Nebula.GetPlayerAddresses:input_type -> nebulapb.GetPlayerAddressesRequest
+	84,  // 100: nebulapb.Nebula.TransferPlayer:input_type -> nebulapb.TransferPlayerRequest
+	86,  // 101: nebulapb.Nebula.TransferServer:input_type -> nebulapb.TransferServerRequest
+	88,  // 102: nebulapb.Nebula.DrainServer:input_type -> nebulapb.DrainServerRequest
+	96,  // 103: nebulapb.Nebula.UpdateAllPlayers:input_type -> nebulapb.UpdateAllPlayersRequest
+	99,  // 104: nebulapb.Nebula.GetPlayerSessions:input_type -> nebulapb.GetPlayerSessionsRequest
+	101, // 105: nebulapb.Nebula.GetPlayerPlaytime:input_type -> nebulapb.GetPlayerPlaytimeRequest
+	108, // 106: nebulapb.Nebula.Broadcast:input_type -> nebulapb.BroadcastRequest
+	110, // 107: nebulapb.Nebula.GetAnnouncements:input_type -> nebulapb.GetAnnouncementsRequest
+	112, // 108: nebulapb.Nebula.AddAnnouncement:input_type -> nebulapb.AddAnnouncementRequest
+	114, // 109: nebulapb.Nebula.RemoveAnnouncement:input_type -> nebulapb.RemoveAnnouncementRequest
+	9,   // 110: nebulapb.Nebula.GetChangesSince:input_type -> nebulapb.GetChangesSinceRequest
+	117, // 111: nebulapb.Nebula.Punish:input_type -> nebulapb.PunishRequest
+	119, // 112: nebulapb.Nebula.Revoke:input_type -> nebulapb.RevokeRequest
+	121, // 113: nebulapb.Nebula.GetPunishments:input_type -> nebulapb.GetPunishmentsRequest
+	123, // 114: nebulapb.Nebula.CheckLogin:input_type -> nebulapb.CheckLoginRequest
+	19,  // 115: nebulapb.Nebula.GetServerEntry:output_type -> nebulapb.GetServerEntryResponse
+	21,  // 116: nebulapb.Nebula.AddServerEntry:output_type -> nebulapb.AddServerEntryResponse
+	23,  // 117: nebulapb.Nebula.RemoveServerEntry:output_type -> nebulapb.RemoveServerEntryResponse
+	27,  // 118: nebulapb.Nebula.GetBungeeEntry:output_type -> nebulapb.GetBungeeEntryResponse
+	29,  // 119: nebulapb.Nebula.SendBungeeCommand:output_type -> nebulapb.SendBungeeCommandResponse
+	31,  // 120: nebulapb.Nebula.SetMotd:output_type -> nebulapb.SetMotdResponse
+	33,  // 121: nebulapb.Nebula.SetFavicon:output_type -> nebulapb.SetFaviconResponse
+	36,  // 122: nebulapb.Nebula.SetWhitelist:output_type -> nebulapb.SetWhitelistResponse
+	38,  // 123: nebulapb.Nebula.AddToWhitelist:output_type -> nebulapb.AddToWhitelistResponse
+	40,  // 124: nebulapb.Nebula.RemoveFromWhitelist:output_type -> nebulapb.RemoveFromWhitelistResponse
+	42,  // 125: nebulapb.Nebula.GetWhitelist:output_type -> nebulapb.GetWhitelistResponse
+	44,  // 126: nebulapb.Nebula.SetLockdown:output_type -> nebulapb.SetLockdownResponse
+	47,  // 127: nebulapb.Nebula.IPLookup:output_type -> nebulapb.IPLookupResponse
+	50,  // 128: nebulapb.Nebula.AddIPFilter:output_type -> nebulapb.AddIPFilterResponse
+	52,  // 129: nebulapb.Nebula.RemoveIPFilter:output_type -> nebulapb.RemoveIPFilterResponse
+	54,  // 130: nebulapb.Nebula.ListIPFilters:output_type -> nebulapb.ListIPFiltersResponse
+	58,  // 131: nebulapb.Nebula.GetIPLookupStats:output_type -> nebulapb.GetIPLookupStatsResponse
+	56,  // 132: nebulapb.Nebula.CheckConnection:output_type -> nebulapb.CheckConnectionResponse
+	62,  // 133: nebulapb.Nebula.ProxyHeartbeat:output_type -> nebulapb.ProxyHeartbeatResponse
+	64,  // 134: nebulapb.Nebula.ResetProxy:output_type -> nebulapb.ResetProxyResponse
+	66,  // 135: nebulapb.Nebula.PlayerLogin:output_type -> nebulapb.PlayerLoginResponse
+	68,  // 136: nebulapb.Nebula.PlayerQuit:output_type -> nebulapb.PlayerQuitResponse
+	70,  // 137: nebulapb.Nebula.PlayerSwitchServer:output_type -> nebulapb.PlayerSwitchServerResponse
+	72,  // 138: nebulapb.Nebula.FetchAllPlayers:output_type -> nebulapb.FetchAllPlayersResponse
+	74,  // 139: nebulapb.Nebula.GetPlayer:output_type -> nebulapb.GetPlayerResponse
+	91,  // 140: nebulapb.Nebula.SetPlayerHidden:output_type -> nebulapb.SetPlayerHiddenResponse
+	93,  // 141: nebulapb.Nebula.GetPlayerByName:output_type -> nebulapb.GetPlayerByNameResponse
+	95,  // 142: nebulapb.Nebula.ListPlayersOnServer:output_type -> nebulapb.ListPlayersOnServerResponse
+	76,  // 143: nebulapb.Nebula.FindPlayer:output_type -> nebulapb.FindPlayerResponse
+	78,  // 144: nebulapb.Nebula.SendPlayerMessage:output_type -> nebulapb.SendPlayerMessageResponse
+	81,  // 145: nebulapb.Nebula.GetAccountsByAddress:output_type -> nebulapb.GetAccountsByAddressResponse
+	83,  // 146: nebulapb.Nebula.GetPlayerAddresses:output_type -> nebulapb.GetPlayerAddressesResponse
+	85,  // 147: nebulapb.Nebula.TransferPlayer:output_type -> nebulapb.TransferPlayerResponse
+	87,  // 148: nebulapb.Nebula.TransferServer:output_type -> nebulapb.TransferServerResponse
+	89,  // 149: nebulapb.Nebula.DrainServer:output_type -> nebulapb.DrainServerResponse
+	97,  // 150: nebulapb.Nebula.UpdateAllPlayers:output_type -> nebulapb.UpdateAllPlayersResponse
+	100, // 151: nebulapb.Nebula.GetPlayerSessions:output_type -> nebulapb.GetPlayerSessionsResponse
+	102, // 152: nebulapb.Nebula.GetPlayerPlaytime:output_type -> nebulapb.GetPlayerPlaytimeResponse
+	109, // 153: nebulapb.Nebula.Broadcast:output_type -> nebulapb.BroadcastResponse
+	111, // 154: nebulapb.Nebula.GetAnnouncements:output_type -> nebulapb.GetAnnouncementsResponse
+	113, // 155: nebulapb.Nebula.AddAnnouncement:output_type -> nebulapb.AddAnnouncementResponse
+	115, // 156: nebulapb.Nebula.RemoveAnnouncement:output_type -> nebulapb.RemoveAnnouncementResponse
+	10,  // 157: nebulapb.Nebula.GetChangesSince:output_type -> nebulapb.GetChangesSinceResponse
+	118, // 158: nebulapb.Nebula.Punish:output_type -> nebulapb.PunishResponse
+	120, // 159: nebulapb.Nebula.Revoke:output_type -> nebulapb.RevokeResponse
+	122, // 160: nebulapb.Nebula.GetPunishments:output_type -> nebulapb.GetPunishmentsResponse
+	124, // 161: nebulapb.Nebula.CheckLogin:output_type -> nebulapb.CheckLoginResponse
+	115, // [115:162] is the sub-list for method output_type
+	68,  // [68:115] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
+}
+
+func init() { file_nebulapb_proto_init() }
+func file_nebulapb_proto_init() {
+	if File_nebulapb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_nebulapb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChangesSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChangesSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerPropertiesStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishmentStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerEntryStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lockdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddServerEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddServerEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServerEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServerEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BungeeEntryStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BungeeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBungeeEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBungeeEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBungeeCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBungeeCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMotdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMotdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFaviconRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFaviconResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWhitelistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWhitelistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToWhitelistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToWhitelistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromWhitelistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromWhitelistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWhitelistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWhitelistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLockdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLockdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPLookupResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPLookupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPFilterEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIPFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIPFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveIPFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveIPFilterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIPFiltersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIPFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConnectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConnectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIPLookupStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIPLookupStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyHeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyHeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetProxyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetProxyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerQuitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerQuitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSwitchServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSwitchServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchAllPlayersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchAllPlayersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPlayerMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendPlayerMessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountsByAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerAddressesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerAddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPlayerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPlayerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServerStatus_Players.ProtoReflect.Descriptor instead.
-func (*ServerStatus_Players) Descriptor() ([]byte, []int) {
-	return file_nebulapb_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *ServerStatus_Players) GetMax() int32 {
-	if x != nil {
-		return x.Max
-	}
-	return 0
-}
-
-func (x *ServerStatus_Players) GetOnline() int32 {
-	if x != nil {
-		return x.Online
-	}
-	return 0
-}
-
-var File_nebulapb_proto protoreflect.FileDescriptor
-
-var file_nebulapb_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x2b, 0x0a, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x73, 0x6f, 0x6c, 0x6f, 0x12, 0x29, 0x0a,
-	0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62,
-	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x37, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0d, 0x0a, 0x09, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x00, 0x12,
-	0x0d, 0x0a, 0x09, 0x51, 0x55, 0x49, 0x54, 0x5f, 0x53, 0x4f, 0x4c, 0x4f, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10,
-	0x02, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a,
-	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e,
-	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x1c, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x81, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x6f, 0x74, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f,
-	0x77, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x2e, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e,
-	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x08,
-	0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
-	0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x1a, 0x39, 0x0a,
-	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x1a, 0x33, 0x0a, 0x07, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x44, 0x0a,
-	0x15, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a,
-	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x42,
-	0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x1d, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x3b, 0x0a, 0x0b,
-	0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d,
-	0x6f, 0x74, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x66, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65,
-	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
-	0x1b, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x0e,
-	0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6d, 0x6f, 0x74, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
-	0x74, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69,
-	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61,
-	0x76, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x76,
-	0x69, 0x63, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69, 0x63,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x12, 0x53, 0x65,
-	0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b,
-	0x64, 0x6f, 0x77, 0x6e, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64,
-	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x62,
-	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x7c, 0x0a, 0x0e, 0x49, 0x50, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73,
-	0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x0f, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x49, 0x50, 0x4c, 0x6f, 0x6f,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65,
-	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x58, 0x0a,
-	0x0e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
-	0x24, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x62, 0x75,
-	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68,
-	0x69, 0x64, 0x65, 0x22, 0x47, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62,
-	0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x15, 0x0a, 0x13,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75,
-	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x18, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
-	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x17, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c,
-	0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbd, 0x08, 0x0a, 0x06, 0x4e, 0x65, 0x62, 0x75,
-	0x6c, 0x61, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65,
-	0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e,
-	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75,
-	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x6e,
-	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x67,
-	0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x75, 0x6e, 0x67, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4d, 0x6f,
-	0x74, 0x64, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6e,
-	0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x74, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x65, 0x74,
-	0x46, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x69, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64,
-	0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x19,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x62, 0x75,
-	0x6c, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70,
-	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51,
-	0x75, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x58, 0x0a, 0x0f, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62,
-	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x21,
-	0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x34, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x2e, 0x73,
-	0x79, 0x6e, 0x63, 0x68, 0x74, 0x68, 0x69, 0x61, 0x2e, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x2e,
-	0x61, 0x70, 0x69, 0x42, 0x0c, 0x4e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x5a, 0x0a, 0x2e, 0x2f, 0x6e, 0x65, 0x62, 0x75, 0x6c, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_nebulapb_proto_rawDescOnce sync.Once
-	file_nebulapb_proto_rawDescData = file_nebulapb_proto_rawDesc
-)
-
-func file_nebulapb_proto_rawDescGZIP() []byte {
-	file_nebulapb_proto_rawDescOnce.Do(func() {
-		file_nebulapb_proto_rawDescData = protoimpl.X.CompressGZIP(file_nebulapb_proto_rawDescData)
-	})
-	return file_nebulapb_proto_rawDescData
-}
-
-var file_nebulapb_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_nebulapb_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
-var file_nebulapb_proto_goTypes = []interface{}{
-	(PlayerPropertiesStream_Type)(0),  // 0: nebulapb.PlayerPropertiesStream.Type
-	(ServerEntryStream_Type)(0),       // 1: nebulapb.ServerEntryStream.Type
-	(BungeeEntryStream_Type)(0),       // 2: nebulapb.BungeeEntryStream.Type
-	(*PlayerPropertiesStream)(nil),    // 3: nebulapb.PlayerPropertiesStream
-	(*ServerEntryStream)(nil),         // 4: nebulapb.ServerEntryStream
-	(*ServerEntry)(nil),               // 5: nebulapb.ServerEntry
-	(*Lockdown)(nil),                  // 6: nebulapb.Lockdown
-	(*ServerStatus)(nil),              // 7: nebulapb.ServerStatus
-	(*GetServerEntryRequest)(nil),     // 8: nebulapb.GetServerEntryRequest
-	(*GetServerEntryResponse)(nil),    // 9: nebulapb.GetServerEntryResponse
-	(*AddServerEntryRequest)(nil),     // 10: nebulapb.AddServerEntryRequest
-	(*AddServerEntryResponse)(nil),    // 11: nebulapb.AddServerEntryResponse
-	(*RemoveServerEntryRequest)(nil),  // 12: nebulapb.RemoveServerEntryRequest
-	(*RemoveServerEntryResponse)(nil), // 13: nebulapb.RemoveServerEntryResponse
-	(*BungeeEntryStream)(nil),         // 14: nebulapb.BungeeEntryStream
-	(*BungeeEntry)(nil),               // 15: nebulapb.BungeeEntry
-	(*GetBungeeEntryRequest)(nil),     // 16: nebulapb.GetBungeeEntryRequest
-	(*GetBungeeEntryResponse)(nil),    // 17: nebulapb.GetBungeeEntryResponse
-	(*SendBungeeCommandRequest)(nil),  // 18: nebulapb.SendBungeeCommandRequest
-	(*SendBungeeCommandResponse)(nil), // 19: nebulapb.SendBungeeCommandResponse
-	(*SetMotdRequest)(nil),            // 20: nebulapb.SetMotdRequest
-	(*SetMotdResponse)(nil),           // 21: nebulapb.SetMotdResponse
-	(*SetFaviconRequest)(nil),         // 22: nebulapb.SetFaviconRequest
-	(*SetFaviconResponse)(nil),        // 23: nebulapb.SetFaviconResponse
-	(*SetLockdownRequest)(nil),        // 24: nebulapb.SetLockdownRequest
-	(*SetLockdownResponse)(nil),       // 25: nebulapb.SetLockdownResponse
-	(*IPLookupResult)(nil),            // 26: nebulapb.IPLookupResult
-	(*IPLookupRequest)(nil),           // 27: nebulapb.IPLookupRequest
-	(*IPLookupResponse)(nil),          // 28: nebulapb.IPLookupResponse
-	(*PlayerProperty)(nil),            // 29: nebulapb.PlayerProperty
-	(*PlayerProfile)(nil),             // 30: nebulapb.PlayerProfile
-	(*PlayerLoginRequest)(nil),        // 31: nebulapb.PlayerLoginRequest
-	(*PlayerLoginResponse)(nil),       // 32: nebulapb.PlayerLoginResponse
-	(*PlayerQuitRequest)(nil),         // 33: nebulapb.PlayerQuitRequest
-	(*PlayerQuitResponse)(nil),        // 34: nebulapb.PlayerQuitResponse
-	(*FetchAllPlayersRequest)(nil),    // 35: nebulapb.FetchAllPlayersRequest
-	(*FetchAllPlayersResponse)(nil),   // 36: nebulapb.FetchAllPlayersResponse
-	(*UpdateAllPlayersRequest)(nil),   // 37: nebulapb.UpdateAllPlayersRequest
-	(*UpdateAllPlayersResponse)(nil),  // 38: nebulapb.UpdateAllPlayersResponse
-	(*ServerStatus_Version)(nil),      // 39: nebulapb.ServerStatus.Version
-	(*ServerStatus_Players)(nil),      // 40: nebulapb.ServerStatus.Players
-}
-var file_nebulapb_proto_depIdxs = []int32{
-	0,  // 0: nebulapb.PlayerPropertiesStream.type:type_name -> nebulapb.PlayerPropertiesStream.Type
-	30, // 1: nebulapb.PlayerPropertiesStream.solo:type_name -> nebulapb.PlayerProfile
-	30, // 2: nebulapb.PlayerPropertiesStream.all:type_name -> nebulapb.PlayerProfile
-	1,  // 3: nebulapb.ServerEntryStream.type:type_name -> nebulapb.ServerEntryStream.Type
-	5,  // 4: nebulapb.ServerEntryStream.entry:type_name -> nebulapb.ServerEntry
-	6,  // 5: nebulapb.ServerEntry.lockdown:type_name -> nebulapb.Lockdown
-	7,  // 6: nebulapb.ServerEntry.status:type_name -> nebulapb.ServerStatus
-	39, // 7: nebulapb.ServerStatus.version:type_name -> nebulapb.ServerStatus.Version
-	40, // 8: nebulapb.ServerStatus.players:type_name -> nebulapb.ServerStatus.Players
-	5,  // 9: nebulapb.GetServerEntryResponse.entry:type_name -> nebulapb.ServerEntry
-	5,  // 10: nebulapb.AddServerEntryRequest.entry:type_name -> nebulapb.ServerEntry
-	2,  // 11: nebulapb.BungeeEntryStream.type:type_name -> nebulapb.BungeeEntryStream.Type
-	15, // 12: nebulapb.BungeeEntryStream.entry:type_name -> nebulapb.BungeeEntry
-	15, // 13: nebulapb.GetBungeeEntryResponse.entry:type_name -> nebulapb.BungeeEntry
-	6,  // 14: nebulapb.SetLockdownRequest.lockdown:type_name -> nebulapb.Lockdown
-	5,  // 15: nebulapb.SetLockdownResponse.entry:type_name -> nebulapb.ServerEntry
-	26, // 16: nebulapb.IPLookupResponse.result:type_name -> nebulapb.IPLookupResult
-	29, // 17: nebulapb.PlayerProfile.properties:type_name -> nebulapb.PlayerProperty
-	30, // 18: nebulapb.PlayerLoginRequest.profile:type_name -> nebulapb.PlayerProfile
-	30, // 19: nebulapb.PlayerQuitRequest.profile:type_name -> nebulapb.PlayerProfile
-	30, // 20: nebulapb.FetchAllPlayersResponse.profiles:type_name -> nebulapb.PlayerProfile
-	30, // 21: nebulapb.UpdateAllPlayersRequest.profiles:type_name -> nebulapb.PlayerProfile
-	8,  // 22: nebulapb.Nebula.GetServerEntry:input_type -> nebulapb.GetServerEntryRequest
-	10, // 23: nebulapb.Nebula.AddServerEntry:input_type -> nebulapb.AddServerEntryRequest
-	12, // 24: nebulapb.Nebula.RemoveServerEntry:input_type -> nebulapb.RemoveServerEntryRequest
-	16, // 25: nebulapb.Nebula.GetBungeeEntry:input_type -> nebulapb.GetBungeeEntryRequest
-	18, // 26: nebulapb.Nebula.SendBungeeCommand:input_type -> nebulapb.SendBungeeCommandRequest
-	20, // 27: nebulapb.Nebula.SetMotd:input_type -> nebulapb.SetMotdRequest
-	22, // 28: nebulapb.Nebula.SetFavicon:input_type -> nebulapb.SetFaviconRequest
-	24, // 29: nebulapb.Nebula.SetLockdown:input_type -> nebulapb.SetLockdownRequest
-	27, // 30: nebulapb.Nebula.IPLookup:input_type -> nebulapb.IPLookupRequest
-	31, // 31: nebulapb.Nebula.PlayerLogin:input_type -> nebulapb.PlayerLoginRequest
-	33, // 32: nebulapb.Nebula.PlayerQuit:input_type -> nebulapb.PlayerQuitRequest
-	35, // 33: nebulapb.Nebula.FetchAllPlayers:input_type -> nebulapb.FetchAllPlayersRequest
-	37, // 34: nebulapb.Nebula.UpdateAllPlayers:input_type -> nebulapb.UpdateAllPlayersRequest
-	9,  // 35: nebulapb.Nebula.GetServerEntry:output_type -> nebulapb.GetServerEntryResponse
-	11, // 36: nebulapb.Nebula.AddServerEntry:output_type -> nebulapb.AddServerEntryResponse
-	13, // 37: nebulapb.Nebula.RemoveServerEntry:output_type -> nebulapb.RemoveServerEntryResponse
-	17, // 38: nebulapb.Nebula.GetBungeeEntry:output_type -> nebulapb.GetBungeeEntryResponse
-	19, // 39: nebulapb.Nebula.SendBungeeCommand:output_type -> nebulapb.SendBungeeCommandResponse
-	21, // 40: nebulapb.Nebula.SetMotd:output_type -> nebulapb.SetMotdResponse
-	23, // 41: nebulapb.Nebula.SetFavicon:output_type -> nebulapb.SetFaviconResponse
-	25, // 42: nebulapb.Nebula.SetLockdown:output_type -> nebulapb.SetLockdownResponse
-	28, // 43: nebulapb.Nebula.IPLookup:output_type -> nebulapb.IPLookupResponse
-	32, // 44: nebulapb.Nebula.PlayerLogin:output_type -> nebulapb.PlayerLoginResponse
-	34, // 45: nebulapb.Nebula.PlayerQuit:output_type -> nebulapb.PlayerQuitResponse
-	36, // 46: nebulapb.Nebula.FetchAllPlayers:output_type -> nebulapb.FetchAllPlayersResponse
-	38, // 47: nebulapb.Nebula.UpdateAllPlayers:output_type -> nebulapb.UpdateAllPlayersResponse
-	35, // [35:48] is the sub-list for method output_type
-	22, // [22:35] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
-}
-
-func init() { file_nebulapb_proto_init() }
-func file_nebulapb_proto_init() {
-	if File_nebulapb_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_nebulapb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerPropertiesStream); i {
+		file_nebulapb_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2502,8 +9801,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerEntryStream); i {
+		file_nebulapb_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2514,8 +9813,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerEntry); i {
+		file_nebulapb_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2526,8 +9825,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lockdown); i {
+		file_nebulapb_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2538,8 +9837,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerStatus); i {
+		file_nebulapb_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerHiddenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2550,8 +9849,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServerEntryRequest); i {
+		file_nebulapb_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPlayerHiddenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2562,8 +9861,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServerEntryResponse); i {
+		file_nebulapb_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerByNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,8 +9873,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddServerEntryRequest); i {
+		file_nebulapb_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerByNameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,8 +9885,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddServerEntryResponse); i {
+		file_nebulapb_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersOnServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,8 +9897,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServerEntryRequest); i {
+		file_nebulapb_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayersOnServerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,8 +9909,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServerEntryResponse); i {
+		file_nebulapb_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAllPlayersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2622,8 +9921,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BungeeEntryStream); i {
+		file_nebulapb_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAllPlayersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2634,8 +9933,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BungeeEntry); i {
+		file_nebulapb_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2646,8 +9945,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBungeeEntryRequest); i {
+		file_nebulapb_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2658,8 +9957,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBungeeEntryResponse); i {
+		file_nebulapb_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2670,8 +9969,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBungeeCommandRequest); i {
+		file_nebulapb_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerPlaytimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2682,8 +9981,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBungeeCommandResponse); i {
+		file_nebulapb_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlayerPlaytimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2694,8 +9993,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMotdRequest); i {
+		file_nebulapb_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2706,8 +10005,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMotdResponse); i {
+		file_nebulapb_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,8 +10017,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFaviconRequest); i {
+		file_nebulapb_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastTitle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,8 +10029,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFaviconResponse); i {
+		file_nebulapb_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Broadcast); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,8 +10041,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLockdownRequest); i {
+		file_nebulapb_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,8 +10053,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLockdownResponse); i {
+		file_nebulapb_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,8 +10065,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPLookupResult); i {
+		file_nebulapb_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BroadcastResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,8 +10077,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPLookupRequest); i {
+		file_nebulapb_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnnouncementsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,8 +10089,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPLookupResponse); i {
+		file_nebulapb_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnnouncementsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,8 +10101,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerProperty); i {
+		file_nebulapb_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAnnouncementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,8 +10113,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerProfile); i {
+		file_nebulapb_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAnnouncementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2826,8 +10125,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerLoginRequest); i {
+		file_nebulapb_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAnnouncementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2838,8 +10137,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerLoginResponse); i {
+		file_nebulapb_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAnnouncementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2850,8 +10149,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerQuitRequest); i {
+		file_nebulapb_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Punishment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2862,8 +10161,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerQuitResponse); i {
+		file_nebulapb_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2874,8 +10173,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchAllPlayersRequest); i {
+		file_nebulapb_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PunishResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2886,8 +10185,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchAllPlayersResponse); i {
+		file_nebulapb_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2898,8 +10197,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAllPlayersRequest); i {
+		file_nebulapb_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2910,8 +10209,8 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAllPlayersResponse); i {
+		file_nebulapb_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPunishmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2922,7 +10221,43 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_nebulapb_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPunishmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nebulapb_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerStatus_Version); i {
 			case 0:
 				return &v.state
@@ -2934,7 +10269,7 @@ func file_nebulapb_proto_init() {
 				return nil
 			}
 		}
-		file_nebulapb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_nebulapb_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerStatus_Players); i {
 			case 0:
 				return &v.state
@@ -2947,13 +10282,21 @@ func file_nebulapb_proto_init() {
 			}
 		}
 	}
+	file_nebulapb_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*StreamEvent_Server)(nil),
+		(*StreamEvent_Bungee)(nil),
+		(*StreamEvent_Player)(nil),
+		(*StreamEvent_Broadcast)(nil),
+		(*StreamEvent_Proxy)(nil),
+		(*StreamEvent_Punishment)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nebulapb_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   38,
+			NumEnums:      8,
+			NumMessages:   119,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
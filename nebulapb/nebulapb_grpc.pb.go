@@ -37,14 +37,61 @@ type NebulaClient interface {
 	// API <- App
 	SetFavicon(ctx context.Context, in *SetFaviconRequest, opts ...grpc.CallOption) (*SetFaviconResponse, error)
 	// API <- App
+	SetWhitelist(ctx context.Context, in *SetWhitelistRequest, opts ...grpc.CallOption) (*SetWhitelistResponse, error)
+	AddToWhitelist(ctx context.Context, in *AddToWhitelistRequest, opts ...grpc.CallOption) (*AddToWhitelistResponse, error)
+	RemoveFromWhitelist(ctx context.Context, in *RemoveFromWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistResponse, error)
+	GetWhitelist(ctx context.Context, in *GetWhitelistRequest, opts ...grpc.CallOption) (*GetWhitelistResponse, error)
+	// API <- App
 	SetLockdown(ctx context.Context, in *SetLockdownRequest, opts ...grpc.CallOption) (*SetLockdownResponse, error)
 	// API <- Bungee / Server
 	IPLookup(ctx context.Context, in *IPLookupRequest, opts ...grpc.CallOption) (*IPLookupResponse, error)
+	// API <- App
+	AddIPFilter(ctx context.Context, in *AddIPFilterRequest, opts ...grpc.CallOption) (*AddIPFilterResponse, error)
+	RemoveIPFilter(ctx context.Context, in *RemoveIPFilterRequest, opts ...grpc.CallOption) (*RemoveIPFilterResponse, error)
+	ListIPFilters(ctx context.Context, in *ListIPFiltersRequest, opts ...grpc.CallOption) (*ListIPFiltersResponse, error)
+	GetIPLookupStats(ctx context.Context, in *GetIPLookupStatsRequest, opts ...grpc.CallOption) (*GetIPLookupStatsResponse, error)
+	// API <- Velocity
+	CheckConnection(ctx context.Context, in *CheckConnectionRequest, opts ...grpc.CallOption) (*CheckConnectionResponse, error)
+	// API <- Velocity
+	ProxyHeartbeat(ctx context.Context, in *ProxyHeartbeatRequest, opts ...grpc.CallOption) (*ProxyHeartbeatResponse, error)
+	ResetProxy(ctx context.Context, in *ResetProxyRequest, opts ...grpc.CallOption) (*ResetProxyResponse, error)
 	// API <- Velocity
 	PlayerLogin(ctx context.Context, in *PlayerLoginRequest, opts ...grpc.CallOption) (*PlayerLoginResponse, error)
 	PlayerQuit(ctx context.Context, in *PlayerQuitRequest, opts ...grpc.CallOption) (*PlayerQuitResponse, error)
+	PlayerSwitchServer(ctx context.Context, in *PlayerSwitchServerRequest, opts ...grpc.CallOption) (*PlayerSwitchServerResponse, error)
 	FetchAllPlayers(ctx context.Context, in *FetchAllPlayersRequest, opts ...grpc.CallOption) (*FetchAllPlayersResponse, error)
+	GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*GetPlayerResponse, error)
+	SetPlayerHidden(ctx context.Context, in *SetPlayerHiddenRequest, opts ...grpc.CallOption) (*SetPlayerHiddenResponse, error)
+	GetPlayerByName(ctx context.Context, in *GetPlayerByNameRequest, opts ...grpc.CallOption) (*GetPlayerByNameResponse, error)
+	ListPlayersOnServer(ctx context.Context, in *ListPlayersOnServerRequest, opts ...grpc.CallOption) (*ListPlayersOnServerResponse, error)
+	FindPlayer(ctx context.Context, in *FindPlayerRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error)
+	// API <- App / Server
+	SendPlayerMessage(ctx context.Context, in *SendPlayerMessageRequest, opts ...grpc.CallOption) (*SendPlayerMessageResponse, error)
+	// API <- App
+	GetAccountsByAddress(ctx context.Context, in *GetAccountsByAddressRequest, opts ...grpc.CallOption) (*GetAccountsByAddressResponse, error)
+	GetPlayerAddresses(ctx context.Context, in *GetPlayerAddressesRequest, opts ...grpc.CallOption) (*GetPlayerAddressesResponse, error)
+	// API <- App
+	TransferPlayer(ctx context.Context, in *TransferPlayerRequest, opts ...grpc.CallOption) (*TransferPlayerResponse, error)
+	TransferServer(ctx context.Context, in *TransferServerRequest, opts ...grpc.CallOption) (*TransferServerResponse, error)
+	DrainServer(ctx context.Context, in *DrainServerRequest, opts ...grpc.CallOption) (*DrainServerResponse, error)
 	UpdateAllPlayers(ctx context.Context, in *UpdateAllPlayersRequest, opts ...grpc.CallOption) (*UpdateAllPlayersResponse, error)
+	// API <- App
+	GetPlayerSessions(ctx context.Context, in *GetPlayerSessionsRequest, opts ...grpc.CallOption) (*GetPlayerSessionsResponse, error)
+	GetPlayerPlaytime(ctx context.Context, in *GetPlayerPlaytimeRequest, opts ...grpc.CallOption) (*GetPlayerPlaytimeResponse, error)
+	// API <- App
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	// API <- App
+	GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*GetAnnouncementsResponse, error)
+	AddAnnouncement(ctx context.Context, in *AddAnnouncementRequest, opts ...grpc.CallOption) (*AddAnnouncementResponse, error)
+	RemoveAnnouncement(ctx context.Context, in *RemoveAnnouncementRequest, opts ...grpc.CallOption) (*RemoveAnnouncementResponse, error)
+	// API -> Bungee / Server (catch up missed stream messages)
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error)
+	// API <- App
+	Punish(ctx context.Context, in *PunishRequest, opts ...grpc.CallOption) (*PunishResponse, error)
+	Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*RevokeResponse, error)
+	GetPunishments(ctx context.Context, in *GetPunishmentsRequest, opts ...grpc.CallOption) (*GetPunishmentsResponse, error)
+	// API <- Velocity (before PlayerLogin)
+	CheckLogin(ctx context.Context, in *CheckLoginRequest, opts ...grpc.CallOption) (*CheckLoginResponse, error)
 }
 
 type nebulaClient struct {
@@ -118,6 +165,42 @@ func (c *nebulaClient) SetFavicon(ctx context.Context, in *SetFaviconRequest, op
 	return out, nil
 }
 
+func (c *nebulaClient) SetWhitelist(ctx context.Context, in *SetWhitelistRequest, opts ...grpc.CallOption) (*SetWhitelistResponse, error) {
+	out := new(SetWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/SetWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) AddToWhitelist(ctx context.Context, in *AddToWhitelistRequest, opts ...grpc.CallOption) (*AddToWhitelistResponse, error) {
+	out := new(AddToWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/AddToWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) RemoveFromWhitelist(ctx context.Context, in *RemoveFromWhitelistRequest, opts ...grpc.CallOption) (*RemoveFromWhitelistResponse, error) {
+	out := new(RemoveFromWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/RemoveFromWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetWhitelist(ctx context.Context, in *GetWhitelistRequest, opts ...grpc.CallOption) (*GetWhitelistResponse, error) {
+	out := new(GetWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nebulaClient) SetLockdown(ctx context.Context, in *SetLockdownRequest, opts ...grpc.CallOption) (*SetLockdownResponse, error) {
 	out := new(SetLockdownResponse)
 	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/SetLockdown", in, out, opts...)
@@ -136,6 +219,69 @@ func (c *nebulaClient) IPLookup(ctx context.Context, in *IPLookupRequest, opts .
 	return out, nil
 }
 
+func (c *nebulaClient) AddIPFilter(ctx context.Context, in *AddIPFilterRequest, opts ...grpc.CallOption) (*AddIPFilterResponse, error) {
+	out := new(AddIPFilterResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/AddIPFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) RemoveIPFilter(ctx context.Context, in *RemoveIPFilterRequest, opts ...grpc.CallOption) (*RemoveIPFilterResponse, error) {
+	out := new(RemoveIPFilterResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/RemoveIPFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) ListIPFilters(ctx context.Context, in *ListIPFiltersRequest, opts ...grpc.CallOption) (*ListIPFiltersResponse, error) {
+	out := new(ListIPFiltersResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/ListIPFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetIPLookupStats(ctx context.Context, in *GetIPLookupStatsRequest, opts ...grpc.CallOption) (*GetIPLookupStatsResponse, error) {
+	out := new(GetIPLookupStatsResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetIPLookupStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) CheckConnection(ctx context.Context, in *CheckConnectionRequest, opts ...grpc.CallOption) (*CheckConnectionResponse, error) {
+	out := new(CheckConnectionResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/CheckConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) ProxyHeartbeat(ctx context.Context, in *ProxyHeartbeatRequest, opts ...grpc.CallOption) (*ProxyHeartbeatResponse, error) {
+	out := new(ProxyHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/ProxyHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) ResetProxy(ctx context.Context, in *ResetProxyRequest, opts ...grpc.CallOption) (*ResetProxyResponse, error) {
+	out := new(ResetProxyResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/ResetProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nebulaClient) PlayerLogin(ctx context.Context, in *PlayerLoginRequest, opts ...grpc.CallOption) (*PlayerLoginResponse, error) {
 	out := new(PlayerLoginResponse)
 	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/PlayerLogin", in, out, opts...)
@@ -154,6 +300,15 @@ func (c *nebulaClient) PlayerQuit(ctx context.Context, in *PlayerQuitRequest, op
 	return out, nil
 }
 
+func (c *nebulaClient) PlayerSwitchServer(ctx context.Context, in *PlayerSwitchServerRequest, opts ...grpc.CallOption) (*PlayerSwitchServerResponse, error) {
+	out := new(PlayerSwitchServerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/PlayerSwitchServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nebulaClient) FetchAllPlayers(ctx context.Context, in *FetchAllPlayersRequest, opts ...grpc.CallOption) (*FetchAllPlayersResponse, error) {
 	out := new(FetchAllPlayersResponse)
 	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/FetchAllPlayers", in, out, opts...)
@@ -163,6 +318,105 @@ func (c *nebulaClient) FetchAllPlayers(ctx context.Context, in *FetchAllPlayersR
 	return out, nil
 }
 
+func (c *nebulaClient) GetPlayer(ctx context.Context, in *GetPlayerRequest, opts ...grpc.CallOption) (*GetPlayerResponse, error) {
+	out := new(GetPlayerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) SetPlayerHidden(ctx context.Context, in *SetPlayerHiddenRequest, opts ...grpc.CallOption) (*SetPlayerHiddenResponse, error) {
+	out := new(SetPlayerHiddenResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/SetPlayerHidden", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetPlayerByName(ctx context.Context, in *GetPlayerByNameRequest, opts ...grpc.CallOption) (*GetPlayerByNameResponse, error) {
+	out := new(GetPlayerByNameResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPlayerByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) ListPlayersOnServer(ctx context.Context, in *ListPlayersOnServerRequest, opts ...grpc.CallOption) (*ListPlayersOnServerResponse, error) {
+	out := new(ListPlayersOnServerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/ListPlayersOnServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) FindPlayer(ctx context.Context, in *FindPlayerRequest, opts ...grpc.CallOption) (*FindPlayerResponse, error) {
+	out := new(FindPlayerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/FindPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) SendPlayerMessage(ctx context.Context, in *SendPlayerMessageRequest, opts ...grpc.CallOption) (*SendPlayerMessageResponse, error) {
+	out := new(SendPlayerMessageResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/SendPlayerMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetAccountsByAddress(ctx context.Context, in *GetAccountsByAddressRequest, opts ...grpc.CallOption) (*GetAccountsByAddressResponse, error) {
+	out := new(GetAccountsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetAccountsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetPlayerAddresses(ctx context.Context, in *GetPlayerAddressesRequest, opts ...grpc.CallOption) (*GetPlayerAddressesResponse, error) {
+	out := new(GetPlayerAddressesResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPlayerAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) TransferPlayer(ctx context.Context, in *TransferPlayerRequest, opts ...grpc.CallOption) (*TransferPlayerResponse, error) {
+	out := new(TransferPlayerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/TransferPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) TransferServer(ctx context.Context, in *TransferServerRequest, opts ...grpc.CallOption) (*TransferServerResponse, error) {
+	out := new(TransferServerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/TransferServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) DrainServer(ctx context.Context, in *DrainServerRequest, opts ...grpc.CallOption) (*DrainServerResponse, error) {
+	out := new(DrainServerResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/DrainServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nebulaClient) UpdateAllPlayers(ctx context.Context, in *UpdateAllPlayersRequest, opts ...grpc.CallOption) (*UpdateAllPlayersResponse, error) {
 	out := new(UpdateAllPlayersResponse)
 	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/UpdateAllPlayers", in, out, opts...)
@@ -172,6 +426,105 @@ func (c *nebulaClient) UpdateAllPlayers(ctx context.Context, in *UpdateAllPlayer
 	return out, nil
 }
 
+func (c *nebulaClient) GetPlayerSessions(ctx context.Context, in *GetPlayerSessionsRequest, opts ...grpc.CallOption) (*GetPlayerSessionsResponse, error) {
+	out := new(GetPlayerSessionsResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPlayerSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetPlayerPlaytime(ctx context.Context, in *GetPlayerPlaytimeRequest, opts ...grpc.CallOption) (*GetPlayerPlaytimeResponse, error) {
+	out := new(GetPlayerPlaytimeResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPlayerPlaytime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/Broadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetAnnouncements(ctx context.Context, in *GetAnnouncementsRequest, opts ...grpc.CallOption) (*GetAnnouncementsResponse, error) {
+	out := new(GetAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetAnnouncements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) AddAnnouncement(ctx context.Context, in *AddAnnouncementRequest, opts ...grpc.CallOption) (*AddAnnouncementResponse, error) {
+	out := new(AddAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/AddAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) RemoveAnnouncement(ctx context.Context, in *RemoveAnnouncementRequest, opts ...grpc.CallOption) (*RemoveAnnouncementResponse, error) {
+	out := new(RemoveAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/RemoveAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error) {
+	out := new(GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetChangesSince", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) Punish(ctx context.Context, in *PunishRequest, opts ...grpc.CallOption) (*PunishResponse, error) {
+	out := new(PunishResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/Punish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) Revoke(ctx context.Context, in *RevokeRequest, opts ...grpc.CallOption) (*RevokeResponse, error) {
+	out := new(RevokeResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/Revoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) GetPunishments(ctx context.Context, in *GetPunishmentsRequest, opts ...grpc.CallOption) (*GetPunishmentsResponse, error) {
+	out := new(GetPunishmentsResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/GetPunishments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nebulaClient) CheckLogin(ctx context.Context, in *CheckLoginRequest, opts ...grpc.CallOption) (*CheckLoginResponse, error) {
+	out := new(CheckLoginResponse)
+	err := c.cc.Invoke(ctx, "/nebulapb.Nebula/CheckLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NebulaServer is the server API for Nebula service.
 // All implementations should embed UnimplementedNebulaServer
 // for forward compatibility
@@ -191,14 +544,61 @@ type NebulaServer interface {
 	// API <- App
 	SetFavicon(context.Context, *SetFaviconRequest) (*SetFaviconResponse, error)
 	// API <- App
+	SetWhitelist(context.Context, *SetWhitelistRequest) (*SetWhitelistResponse, error)
+	AddToWhitelist(context.Context, *AddToWhitelistRequest) (*AddToWhitelistResponse, error)
+	RemoveFromWhitelist(context.Context, *RemoveFromWhitelistRequest) (*RemoveFromWhitelistResponse, error)
+	GetWhitelist(context.Context, *GetWhitelistRequest) (*GetWhitelistResponse, error)
+	// API <- App
 	SetLockdown(context.Context, *SetLockdownRequest) (*SetLockdownResponse, error)
 	// API <- Bungee / Server
 	IPLookup(context.Context, *IPLookupRequest) (*IPLookupResponse, error)
+	// API <- App
+	AddIPFilter(context.Context, *AddIPFilterRequest) (*AddIPFilterResponse, error)
+	RemoveIPFilter(context.Context, *RemoveIPFilterRequest) (*RemoveIPFilterResponse, error)
+	ListIPFilters(context.Context, *ListIPFiltersRequest) (*ListIPFiltersResponse, error)
+	GetIPLookupStats(context.Context, *GetIPLookupStatsRequest) (*GetIPLookupStatsResponse, error)
+	// API <- Velocity
+	CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error)
+	// API <- Velocity
+	ProxyHeartbeat(context.Context, *ProxyHeartbeatRequest) (*ProxyHeartbeatResponse, error)
+	ResetProxy(context.Context, *ResetProxyRequest) (*ResetProxyResponse, error)
 	// API <- Velocity
 	PlayerLogin(context.Context, *PlayerLoginRequest) (*PlayerLoginResponse, error)
 	PlayerQuit(context.Context, *PlayerQuitRequest) (*PlayerQuitResponse, error)
+	PlayerSwitchServer(context.Context, *PlayerSwitchServerRequest) (*PlayerSwitchServerResponse, error)
 	FetchAllPlayers(context.Context, *FetchAllPlayersRequest) (*FetchAllPlayersResponse, error)
+	GetPlayer(context.Context, *GetPlayerRequest) (*GetPlayerResponse, error)
+	SetPlayerHidden(context.Context, *SetPlayerHiddenRequest) (*SetPlayerHiddenResponse, error)
+	GetPlayerByName(context.Context, *GetPlayerByNameRequest) (*GetPlayerByNameResponse, error)
+	ListPlayersOnServer(context.Context, *ListPlayersOnServerRequest) (*ListPlayersOnServerResponse, error)
+	FindPlayer(context.Context, *FindPlayerRequest) (*FindPlayerResponse, error)
+	// API <- App / Server
+	SendPlayerMessage(context.Context, *SendPlayerMessageRequest) (*SendPlayerMessageResponse, error)
+	// API <- App
+	GetAccountsByAddress(context.Context, *GetAccountsByAddressRequest) (*GetAccountsByAddressResponse, error)
+	GetPlayerAddresses(context.Context, *GetPlayerAddressesRequest) (*GetPlayerAddressesResponse, error)
+	// API <- App
+	TransferPlayer(context.Context, *TransferPlayerRequest) (*TransferPlayerResponse, error)
+	TransferServer(context.Context, *TransferServerRequest) (*TransferServerResponse, error)
+	DrainServer(context.Context, *DrainServerRequest) (*DrainServerResponse, error)
 	UpdateAllPlayers(context.Context, *UpdateAllPlayersRequest) (*UpdateAllPlayersResponse, error)
+	// API <- App
+	GetPlayerSessions(context.Context, *GetPlayerSessionsRequest) (*GetPlayerSessionsResponse, error)
+	GetPlayerPlaytime(context.Context, *GetPlayerPlaytimeRequest) (*GetPlayerPlaytimeResponse, error)
+	// API <- App
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	// API <- App
+	GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*GetAnnouncementsResponse, error)
+	AddAnnouncement(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error)
+	RemoveAnnouncement(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error)
+	// API -> Bungee / Server (catch up missed stream messages)
+	GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error)
+	// API <- App
+	Punish(context.Context, *PunishRequest) (*PunishResponse, error)
+	Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error)
+	GetPunishments(context.Context, *GetPunishmentsRequest) (*GetPunishmentsResponse, error)
+	// API <- Velocity (before PlayerLogin)
+	CheckLogin(context.Context, *CheckLoginRequest) (*CheckLoginResponse, error)
 }
 
 // UnimplementedNebulaServer should be embedded to have forward compatible implementations.
@@ -226,266 +626,980 @@ func (UnimplementedNebulaServer) SetMotd(context.Context, *SetMotdRequest) (*Set
 func (UnimplementedNebulaServer) SetFavicon(context.Context, *SetFaviconRequest) (*SetFaviconResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetFavicon not implemented")
 }
-func (UnimplementedNebulaServer) SetLockdown(context.Context, *SetLockdownRequest) (*SetLockdownResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLockdown not implemented")
+func (UnimplementedNebulaServer) SetWhitelist(context.Context, *SetWhitelistRequest) (*SetWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWhitelist not implemented")
+}
+func (UnimplementedNebulaServer) AddToWhitelist(context.Context, *AddToWhitelistRequest) (*AddToWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelist not implemented")
+}
+func (UnimplementedNebulaServer) RemoveFromWhitelist(context.Context, *RemoveFromWhitelistRequest) (*RemoveFromWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWhitelist not implemented")
+}
+func (UnimplementedNebulaServer) GetWhitelist(context.Context, *GetWhitelistRequest) (*GetWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWhitelist not implemented")
+}
+func (UnimplementedNebulaServer) SetLockdown(context.Context, *SetLockdownRequest) (*SetLockdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockdown not implemented")
+}
+func (UnimplementedNebulaServer) IPLookup(context.Context, *IPLookupRequest) (*IPLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IPLookup not implemented")
+}
+func (UnimplementedNebulaServer) AddIPFilter(context.Context, *AddIPFilterRequest) (*AddIPFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIPFilter not implemented")
+}
+func (UnimplementedNebulaServer) RemoveIPFilter(context.Context, *RemoveIPFilterRequest) (*RemoveIPFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIPFilter not implemented")
+}
+func (UnimplementedNebulaServer) ListIPFilters(context.Context, *ListIPFiltersRequest) (*ListIPFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPFilters not implemented")
+}
+func (UnimplementedNebulaServer) GetIPLookupStats(context.Context, *GetIPLookupStatsRequest) (*GetIPLookupStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIPLookupStats not implemented")
+}
+func (UnimplementedNebulaServer) CheckConnection(context.Context, *CheckConnectionRequest) (*CheckConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConnection not implemented")
+}
+func (UnimplementedNebulaServer) ProxyHeartbeat(context.Context, *ProxyHeartbeatRequest) (*ProxyHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyHeartbeat not implemented")
+}
+func (UnimplementedNebulaServer) ResetProxy(context.Context, *ResetProxyRequest) (*ResetProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetProxy not implemented")
+}
+func (UnimplementedNebulaServer) PlayerLogin(context.Context, *PlayerLoginRequest) (*PlayerLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerLogin not implemented")
+}
+func (UnimplementedNebulaServer) PlayerQuit(context.Context, *PlayerQuitRequest) (*PlayerQuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerQuit not implemented")
+}
+func (UnimplementedNebulaServer) PlayerSwitchServer(context.Context, *PlayerSwitchServerRequest) (*PlayerSwitchServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayerSwitchServer not implemented")
+}
+func (UnimplementedNebulaServer) FetchAllPlayers(context.Context, *FetchAllPlayersRequest) (*FetchAllPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchAllPlayers not implemented")
+}
+func (UnimplementedNebulaServer) GetPlayer(context.Context, *GetPlayerRequest) (*GetPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayer not implemented")
+}
+func (UnimplementedNebulaServer) SetPlayerHidden(context.Context, *SetPlayerHiddenRequest) (*SetPlayerHiddenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerHidden not implemented")
+}
+func (UnimplementedNebulaServer) GetPlayerByName(context.Context, *GetPlayerByNameRequest) (*GetPlayerByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerByName not implemented")
+}
+func (UnimplementedNebulaServer) ListPlayersOnServer(context.Context, *ListPlayersOnServerRequest) (*ListPlayersOnServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayersOnServer not implemented")
+}
+func (UnimplementedNebulaServer) FindPlayer(context.Context, *FindPlayerRequest) (*FindPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPlayer not implemented")
+}
+func (UnimplementedNebulaServer) SendPlayerMessage(context.Context, *SendPlayerMessageRequest) (*SendPlayerMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPlayerMessage not implemented")
+}
+func (UnimplementedNebulaServer) GetAccountsByAddress(context.Context, *GetAccountsByAddressRequest) (*GetAccountsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountsByAddress not implemented")
+}
+func (UnimplementedNebulaServer) GetPlayerAddresses(context.Context, *GetPlayerAddressesRequest) (*GetPlayerAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerAddresses not implemented")
+}
+func (UnimplementedNebulaServer) TransferPlayer(context.Context, *TransferPlayerRequest) (*TransferPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPlayer not implemented")
+}
+func (UnimplementedNebulaServer) TransferServer(context.Context, *TransferServerRequest) (*TransferServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferServer not implemented")
+}
+func (UnimplementedNebulaServer) DrainServer(context.Context, *DrainServerRequest) (*DrainServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainServer not implemented")
+}
+func (UnimplementedNebulaServer) UpdateAllPlayers(context.Context, *UpdateAllPlayersRequest) (*UpdateAllPlayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllPlayers not implemented")
+}
+func (UnimplementedNebulaServer) GetPlayerSessions(context.Context, *GetPlayerSessionsRequest) (*GetPlayerSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerSessions not implemented")
+}
+func (UnimplementedNebulaServer) GetPlayerPlaytime(context.Context, *GetPlayerPlaytimeRequest) (*GetPlayerPlaytimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerPlaytime not implemented")
+}
+func (UnimplementedNebulaServer) Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (UnimplementedNebulaServer) GetAnnouncements(context.Context, *GetAnnouncementsRequest) (*GetAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnnouncements not implemented")
+}
+func (UnimplementedNebulaServer) AddAnnouncement(context.Context, *AddAnnouncementRequest) (*AddAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAnnouncement not implemented")
+}
+func (UnimplementedNebulaServer) RemoveAnnouncement(context.Context, *RemoveAnnouncementRequest) (*RemoveAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAnnouncement not implemented")
+}
+func (UnimplementedNebulaServer) GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
+func (UnimplementedNebulaServer) Punish(context.Context, *PunishRequest) (*PunishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Punish not implemented")
+}
+func (UnimplementedNebulaServer) Revoke(context.Context, *RevokeRequest) (*RevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedNebulaServer) GetPunishments(context.Context, *GetPunishmentsRequest) (*GetPunishmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPunishments not implemented")
+}
+func (UnimplementedNebulaServer) CheckLogin(context.Context, *CheckLoginRequest) (*CheckLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLogin not implemented")
+}
+
+// UnsafeNebulaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NebulaServer will
+// result in compilation errors.
+type UnsafeNebulaServer interface {
+	mustEmbedUnimplementedNebulaServer()
+}
+
+func RegisterNebulaServer(s grpc.ServiceRegistrar, srv NebulaServer) {
+	s.RegisterService(&Nebula_ServiceDesc, srv)
+}
+
+func _Nebula_GetServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetServerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetServerEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetServerEntry(ctx, req.(*GetServerEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_AddServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServerEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).AddServerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/AddServerEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).AddServerEntry(ctx, req.(*AddServerEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_RemoveServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServerEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).RemoveServerEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/RemoveServerEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).RemoveServerEntry(ctx, req.(*RemoveServerEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_GetBungeeEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBungeeEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetBungeeEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetBungeeEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetBungeeEntry(ctx, req.(*GetBungeeEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SendBungeeCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendBungeeCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SendBungeeCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SendBungeeCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SendBungeeCommand(ctx, req.(*SendBungeeCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SetMotd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMotdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SetMotd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SetMotd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SetMotd(ctx, req.(*SetMotdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SetFavicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaviconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SetFavicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SetFavicon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SetFavicon(ctx, req.(*SetFaviconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SetWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SetWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SetWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SetWhitelist(ctx, req.(*SetWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_AddToWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).AddToWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/AddToWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).AddToWhitelist(ctx, req.(*AddToWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_RemoveFromWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).RemoveFromWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/RemoveFromWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).RemoveFromWhitelist(ctx, req.(*RemoveFromWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_GetWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetWhitelist(ctx, req.(*GetWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SetLockdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLockdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SetLockdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SetLockdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SetLockdown(ctx, req.(*SetLockdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_IPLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).IPLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/IPLookup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).IPLookup(ctx, req.(*IPLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_AddIPFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIPFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).AddIPFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/AddIPFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).AddIPFilter(ctx, req.(*AddIPFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_RemoveIPFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveIPFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).RemoveIPFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/RemoveIPFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).RemoveIPFilter(ctx, req.(*RemoveIPFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_ListIPFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIPFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).ListIPFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/ListIPFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).ListIPFilters(ctx, req.(*ListIPFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_GetIPLookupStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIPLookupStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetIPLookupStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetIPLookupStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetIPLookupStats(ctx, req.(*GetIPLookupStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_CheckConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).CheckConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/CheckConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).CheckConnection(ctx, req.(*CheckConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_ProxyHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProxyHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).ProxyHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/ProxyHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).ProxyHeartbeat(ctx, req.(*ProxyHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_ResetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetProxyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).ResetProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/ResetProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).ResetProxy(ctx, req.(*ResetProxyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_PlayerLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).PlayerLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/PlayerLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).PlayerLogin(ctx, req.(*PlayerLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_PlayerQuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerQuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).PlayerQuit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/PlayerQuit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).PlayerQuit(ctx, req.(*PlayerQuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_PlayerSwitchServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayerSwitchServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).PlayerSwitchServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/PlayerSwitchServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).PlayerSwitchServer(ctx, req.(*PlayerSwitchServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_FetchAllPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchAllPlayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).FetchAllPlayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/FetchAllPlayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).FetchAllPlayers(ctx, req.(*FetchAllPlayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_GetPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetPlayer(ctx, req.(*GetPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_SetPlayerHidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerHiddenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SetPlayerHidden(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SetPlayerHidden",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SetPlayerHidden(ctx, req.(*SetPlayerHiddenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Nebula_GetPlayerByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetPlayerByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetPlayerByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetPlayerByName(ctx, req.(*GetPlayerByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNebulaServer) IPLookup(context.Context, *IPLookupRequest) (*IPLookupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IPLookup not implemented")
+
+func _Nebula_ListPlayersOnServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayersOnServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).ListPlayersOnServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/ListPlayersOnServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).ListPlayersOnServer(ctx, req.(*ListPlayersOnServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNebulaServer) PlayerLogin(context.Context, *PlayerLoginRequest) (*PlayerLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayerLogin not implemented")
+
+func _Nebula_FindPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).FindPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/FindPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).FindPlayer(ctx, req.(*FindPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNebulaServer) PlayerQuit(context.Context, *PlayerQuitRequest) (*PlayerQuitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlayerQuit not implemented")
+
+func _Nebula_SendPlayerMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPlayerMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).SendPlayerMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/SendPlayerMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).SendPlayerMessage(ctx, req.(*SendPlayerMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNebulaServer) FetchAllPlayers(context.Context, *FetchAllPlayersRequest) (*FetchAllPlayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchAllPlayers not implemented")
+
+func _Nebula_GetAccountsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetAccountsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetAccountsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetAccountsByAddress(ctx, req.(*GetAccountsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNebulaServer) UpdateAllPlayers(context.Context, *UpdateAllPlayersRequest) (*UpdateAllPlayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllPlayers not implemented")
+
+func _Nebula_GetPlayerAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).GetPlayerAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/GetPlayerAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).GetPlayerAddresses(ctx, req.(*GetPlayerAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeNebulaServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NebulaServer will
-// result in compilation errors.
-type UnsafeNebulaServer interface {
-	mustEmbedUnimplementedNebulaServer()
+func _Nebula_TransferPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).TransferPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/TransferPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).TransferPlayer(ctx, req.(*TransferPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNebulaServer(s grpc.ServiceRegistrar, srv NebulaServer) {
-	s.RegisterService(&Nebula_ServiceDesc, srv)
+func _Nebula_TransferServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NebulaServer).TransferServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nebulapb.Nebula/TransferServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NebulaServer).TransferServer(ctx, req.(*TransferServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_GetServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServerEntryRequest)
+func _Nebula_DrainServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainServerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).GetServerEntry(ctx, in)
+		return srv.(NebulaServer).DrainServer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/GetServerEntry",
+		FullMethod: "/nebulapb.Nebula/DrainServer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).GetServerEntry(ctx, req.(*GetServerEntryRequest))
+		return srv.(NebulaServer).DrainServer(ctx, req.(*DrainServerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_AddServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddServerEntryRequest)
+func _Nebula_UpdateAllPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAllPlayersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).AddServerEntry(ctx, in)
+		return srv.(NebulaServer).UpdateAllPlayers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/AddServerEntry",
+		FullMethod: "/nebulapb.Nebula/UpdateAllPlayers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).AddServerEntry(ctx, req.(*AddServerEntryRequest))
+		return srv.(NebulaServer).UpdateAllPlayers(ctx, req.(*UpdateAllPlayersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_RemoveServerEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveServerEntryRequest)
+func _Nebula_GetPlayerSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).RemoveServerEntry(ctx, in)
+		return srv.(NebulaServer).GetPlayerSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/RemoveServerEntry",
+		FullMethod: "/nebulapb.Nebula/GetPlayerSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).RemoveServerEntry(ctx, req.(*RemoveServerEntryRequest))
+		return srv.(NebulaServer).GetPlayerSessions(ctx, req.(*GetPlayerSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_GetBungeeEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBungeeEntryRequest)
+func _Nebula_GetPlayerPlaytime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerPlaytimeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).GetBungeeEntry(ctx, in)
+		return srv.(NebulaServer).GetPlayerPlaytime(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/GetBungeeEntry",
+		FullMethod: "/nebulapb.Nebula/GetPlayerPlaytime",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).GetBungeeEntry(ctx, req.(*GetBungeeEntryRequest))
+		return srv.(NebulaServer).GetPlayerPlaytime(ctx, req.(*GetPlayerPlaytimeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_SendBungeeCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendBungeeCommandRequest)
+func _Nebula_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).SendBungeeCommand(ctx, in)
+		return srv.(NebulaServer).Broadcast(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/SendBungeeCommand",
+		FullMethod: "/nebulapb.Nebula/Broadcast",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).SendBungeeCommand(ctx, req.(*SendBungeeCommandRequest))
+		return srv.(NebulaServer).Broadcast(ctx, req.(*BroadcastRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_SetMotd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetMotdRequest)
+func _Nebula_GetAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnnouncementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).SetMotd(ctx, in)
+		return srv.(NebulaServer).GetAnnouncements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/SetMotd",
+		FullMethod: "/nebulapb.Nebula/GetAnnouncements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).SetMotd(ctx, req.(*SetMotdRequest))
+		return srv.(NebulaServer).GetAnnouncements(ctx, req.(*GetAnnouncementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_SetFavicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetFaviconRequest)
+func _Nebula_AddAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).SetFavicon(ctx, in)
+		return srv.(NebulaServer).AddAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/SetFavicon",
+		FullMethod: "/nebulapb.Nebula/AddAnnouncement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).SetFavicon(ctx, req.(*SetFaviconRequest))
+		return srv.(NebulaServer).AddAnnouncement(ctx, req.(*AddAnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_SetLockdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLockdownRequest)
+func _Nebula_RemoveAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAnnouncementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).SetLockdown(ctx, in)
+		return srv.(NebulaServer).RemoveAnnouncement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/SetLockdown",
+		FullMethod: "/nebulapb.Nebula/RemoveAnnouncement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).SetLockdown(ctx, req.(*SetLockdownRequest))
+		return srv.(NebulaServer).RemoveAnnouncement(ctx, req.(*RemoveAnnouncementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_IPLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IPLookupRequest)
+func _Nebula_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesSinceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).IPLookup(ctx, in)
+		return srv.(NebulaServer).GetChangesSince(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/IPLookup",
+		FullMethod: "/nebulapb.Nebula/GetChangesSince",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).IPLookup(ctx, req.(*IPLookupRequest))
+		return srv.(NebulaServer).GetChangesSince(ctx, req.(*GetChangesSinceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_PlayerLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlayerLoginRequest)
+func _Nebula_Punish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PunishRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).PlayerLogin(ctx, in)
+		return srv.(NebulaServer).Punish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/PlayerLogin",
+		FullMethod: "/nebulapb.Nebula/Punish",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).PlayerLogin(ctx, req.(*PlayerLoginRequest))
+		return srv.(NebulaServer).Punish(ctx, req.(*PunishRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_PlayerQuit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PlayerQuitRequest)
+func _Nebula_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).PlayerQuit(ctx, in)
+		return srv.(NebulaServer).Revoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/PlayerQuit",
+		FullMethod: "/nebulapb.Nebula/Revoke",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).PlayerQuit(ctx, req.(*PlayerQuitRequest))
+		return srv.(NebulaServer).Revoke(ctx, req.(*RevokeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_FetchAllPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FetchAllPlayersRequest)
+func _Nebula_GetPunishments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPunishmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).FetchAllPlayers(ctx, in)
+		return srv.(NebulaServer).GetPunishments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/FetchAllPlayers",
+		FullMethod: "/nebulapb.Nebula/GetPunishments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).FetchAllPlayers(ctx, req.(*FetchAllPlayersRequest))
+		return srv.(NebulaServer).GetPunishments(ctx, req.(*GetPunishmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Nebula_UpdateAllPlayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAllPlayersRequest)
+func _Nebula_CheckLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckLoginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NebulaServer).UpdateAllPlayers(ctx, in)
+		return srv.(NebulaServer).CheckLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nebulapb.Nebula/UpdateAllPlayers",
+		FullMethod: "/nebulapb.Nebula/CheckLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NebulaServer).UpdateAllPlayers(ctx, req.(*UpdateAllPlayersRequest))
+		return srv.(NebulaServer).CheckLogin(ctx, req.(*CheckLoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -525,6 +1639,22 @@ var Nebula_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetFavicon",
 			Handler:    _Nebula_SetFavicon_Handler,
 		},
+		{
+			MethodName: "SetWhitelist",
+			Handler:    _Nebula_SetWhitelist_Handler,
+		},
+		{
+			MethodName: "AddToWhitelist",
+			Handler:    _Nebula_AddToWhitelist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWhitelist",
+			Handler:    _Nebula_RemoveFromWhitelist_Handler,
+		},
+		{
+			MethodName: "GetWhitelist",
+			Handler:    _Nebula_GetWhitelist_Handler,
+		},
 		{
 			MethodName: "SetLockdown",
 			Handler:    _Nebula_SetLockdown_Handler,
@@ -533,6 +1663,34 @@ var Nebula_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IPLookup",
 			Handler:    _Nebula_IPLookup_Handler,
 		},
+		{
+			MethodName: "AddIPFilter",
+			Handler:    _Nebula_AddIPFilter_Handler,
+		},
+		{
+			MethodName: "RemoveIPFilter",
+			Handler:    _Nebula_RemoveIPFilter_Handler,
+		},
+		{
+			MethodName: "ListIPFilters",
+			Handler:    _Nebula_ListIPFilters_Handler,
+		},
+		{
+			MethodName: "GetIPLookupStats",
+			Handler:    _Nebula_GetIPLookupStats_Handler,
+		},
+		{
+			MethodName: "CheckConnection",
+			Handler:    _Nebula_CheckConnection_Handler,
+		},
+		{
+			MethodName: "ProxyHeartbeat",
+			Handler:    _Nebula_ProxyHeartbeat_Handler,
+		},
+		{
+			MethodName: "ResetProxy",
+			Handler:    _Nebula_ResetProxy_Handler,
+		},
 		{
 			MethodName: "PlayerLogin",
 			Handler:    _Nebula_PlayerLogin_Handler,
@@ -541,14 +1699,106 @@ var Nebula_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlayerQuit",
 			Handler:    _Nebula_PlayerQuit_Handler,
 		},
+		{
+			MethodName: "PlayerSwitchServer",
+			Handler:    _Nebula_PlayerSwitchServer_Handler,
+		},
 		{
 			MethodName: "FetchAllPlayers",
 			Handler:    _Nebula_FetchAllPlayers_Handler,
 		},
+		{
+			MethodName: "GetPlayer",
+			Handler:    _Nebula_GetPlayer_Handler,
+		},
+		{
+			MethodName: "SetPlayerHidden",
+			Handler:    _Nebula_SetPlayerHidden_Handler,
+		},
+		{
+			MethodName: "GetPlayerByName",
+			Handler:    _Nebula_GetPlayerByName_Handler,
+		},
+		{
+			MethodName: "ListPlayersOnServer",
+			Handler:    _Nebula_ListPlayersOnServer_Handler,
+		},
+		{
+			MethodName: "FindPlayer",
+			Handler:    _Nebula_FindPlayer_Handler,
+		},
+		{
+			MethodName: "SendPlayerMessage",
+			Handler:    _Nebula_SendPlayerMessage_Handler,
+		},
+		{
+			MethodName: "GetAccountsByAddress",
+			Handler:    _Nebula_GetAccountsByAddress_Handler,
+		},
+		{
+			MethodName: "GetPlayerAddresses",
+			Handler:    _Nebula_GetPlayerAddresses_Handler,
+		},
+		{
+			MethodName: "TransferPlayer",
+			Handler:    _Nebula_TransferPlayer_Handler,
+		},
+		{
+			MethodName: "TransferServer",
+			Handler:    _Nebula_TransferServer_Handler,
+		},
+		{
+			MethodName: "DrainServer",
+			Handler:    _Nebula_DrainServer_Handler,
+		},
 		{
 			MethodName: "UpdateAllPlayers",
 			Handler:    _Nebula_UpdateAllPlayers_Handler,
 		},
+		{
+			MethodName: "GetPlayerSessions",
+			Handler:    _Nebula_GetPlayerSessions_Handler,
+		},
+		{
+			MethodName: "GetPlayerPlaytime",
+			Handler:    _Nebula_GetPlayerPlaytime_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _Nebula_Broadcast_Handler,
+		},
+		{
+			MethodName: "GetAnnouncements",
+			Handler:    _Nebula_GetAnnouncements_Handler,
+		},
+		{
+			MethodName: "AddAnnouncement",
+			Handler:    _Nebula_AddAnnouncement_Handler,
+		},
+		{
+			MethodName: "RemoveAnnouncement",
+			Handler:    _Nebula_RemoveAnnouncement_Handler,
+		},
+		{
+			MethodName: "GetChangesSince",
+			Handler:    _Nebula_GetChangesSince_Handler,
+		},
+		{
+			MethodName: "Punish",
+			Handler:    _Nebula_Punish_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _Nebula_Revoke_Handler,
+		},
+		{
+			MethodName: "GetPunishments",
+			Handler:    _Nebula_GetPunishments_Handler,
+		},
+		{
+			MethodName: "CheckLogin",
+			Handler:    _Nebula_CheckLogin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nebulapb.proto",
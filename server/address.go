@@ -0,0 +1,79 @@
+package server
+
+import (
+	"errors"
+
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) GetAccountsByAddress(ctx context.Context, e *pb.GetAccountsByAddressRequest) (*pb.GetAccountsByAddressResponse, error) {
+	address, subnet, err := s.svc.Addresses.Hash(e.IpAddress)
+	if err != nil {
+		return &pb.GetAccountsByAddressResponse{}, errors.New("invalid address")
+	}
+	if !e.Subnet {
+		subnet = ""
+	}
+
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 1000 {
+		limit = 1000
+	}
+
+	r, err := s.svc.MySQL.GetAddressPlayers(address, subnet, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// newest address of each player
+	var resp []*pb.PlayerAddress
+	found := map[string]*pb.PlayerAddress{}
+	for _, r := range r {
+		if a, ok := found[r.UUID]; ok {
+			a.Count += r.Count
+			if r.FirstSeen.Unix() < a.FirstSeen {
+				a.FirstSeen = r.FirstSeen.Unix()
+			}
+			continue
+		}
+		a := r.ToProtobuf()
+		found[r.UUID] = a
+		resp = append(resp, a)
+	}
+
+	return &pb.GetAccountsByAddressResponse{
+		Addresses: resp,
+		Hashed:    s.svc.Addresses.Hashed(),
+	}, nil
+}
+
+func (s *grpcServer) GetPlayerAddresses(ctx context.Context, e *pb.GetPlayerAddressesRequest) (*pb.GetPlayerAddressesResponse, error) {
+	if len(e.PlayerUUID) == 0 {
+		return &pb.GetPlayerAddressesResponse{}, errors.New("playerUUID is empty")
+	}
+
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	r, err := s.svc.MySQL.GetPlayerAddresses(e.PlayerUUID, limit, int(e.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.PlayerAddress
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.GetPlayerAddressesResponse{
+		Addresses: resp,
+		Hashed:    s.svc.Addresses.Hashed(),
+	}, nil
+}
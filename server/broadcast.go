@@ -0,0 +1,105 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) Broadcast(ctx context.Context, e *pb.BroadcastRequest) (*pb.BroadcastResponse, error) {
+	if e.Broadcast == nil {
+		return &pb.BroadcastResponse{}, errors.New("broadcast is empty")
+	}
+	if err := validateBroadcastTarget(e.Broadcast.Target); err != nil {
+		return &pb.BroadcastResponse{}, err
+	}
+
+	if err := s.svc.MySQL.Enqueue(stream.BroadcastMessage(e.Broadcast, 0)); err != nil {
+		return &pb.BroadcastResponse{}, err
+	}
+
+	return &pb.BroadcastResponse{}, nil
+}
+
+// validateBroadcastTarget - Target other than ALL requires value (nil means ALL)
+func validateBroadcastTarget(target *pb.BroadcastTarget) error {
+	if target == nil || target.Type == pb.BroadcastTarget_ALL {
+		return nil
+	}
+	if len(target.Value) == 0 {
+		return errors.New("target value is empty")
+	}
+	return nil
+}
+
+func (s *grpcServer) GetAnnouncements(ctx context.Context, e *pb.GetAnnouncementsRequest) (*pb.GetAnnouncementsResponse, error) {
+	r, err := s.svc.MySQL.GetAllAnnouncements()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.Announcement
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.GetAnnouncementsResponse{
+		Announcements: resp,
+	}, nil
+}
+
+func (s *grpcServer) AddAnnouncement(ctx context.Context, e *pb.AddAnnouncementRequest) (*pb.AddAnnouncementResponse, error) {
+	if e.Announcement == nil || e.Announcement.Broadcast == nil {
+		return &pb.AddAnnouncementResponse{}, errors.New("announcement is empty")
+	}
+	if e.Announcement.Interval <= 0 {
+		return &pb.AddAnnouncementResponse{}, errors.New("interval must be positive")
+	}
+	if err := validateBroadcastTarget(e.Announcement.Broadcast.Target); err != nil {
+		return &pb.AddAnnouncementResponse{}, err
+	}
+
+	// there is no RPC to enable it later
+	entry := database.AnnouncementsFromProtobuf(e.Announcement)
+	entry.Id = 0
+	entry.Enabled = true
+	if err := s.svc.MySQL.AddAnnouncement(entry); err != nil {
+		return &pb.AddAnnouncementResponse{}, err
+	}
+
+	return &pb.AddAnnouncementResponse{Announcement: entry.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) RemoveAnnouncement(ctx context.Context, e *pb.RemoveAnnouncementRequest) (*pb.RemoveAnnouncementResponse, error) {
+	err := s.svc.MySQL.RemoveAnnouncement(e.Id)
+	return &pb.RemoveAnnouncementResponse{}, err
+}
+
+func (s *grpcServer) announcing() {
+	now := time.Now()
+	e, err := s.svc.MySQL.GetDueAnnouncements(now)
+	if err != nil {
+		logrus.Errorf("[Database] Error %s", err)
+		return
+	}
+
+	for _, v := range e {
+		err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+			claimed, err := tx.ClaimAnnouncement(v, now)
+			if err != nil || !claimed {
+				return err
+			}
+
+			logrus.Debugf("[Announcement] Publishing: %d", v.Id)
+			return tx.Enqueue(stream.BroadcastMessage(v.BroadcastProtobuf(), v.Id))
+		})
+		if err != nil {
+			logrus.WithError(err).Errorf("[Announcement] Failed publish announcement: %d", v.Id)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) GetChangesSince(ctx context.Context, e *pb.GetChangesSinceRequest) (*pb.GetChangesSinceResponse, error) {
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 1000 {
+		limit = 1000
+	}
+
+	oldest, latest, err := s.svc.MySQL.GetOutboxBounds()
+	if err != nil {
+		return nil, err
+	}
+
+	// staff channel is superset of player channel: return only one of them
+	exclude := []string{stream.PlayerStaffChannel}
+	if e.IncludeHidden {
+		exclude = []string{stream.PlayerChannel}
+		if len(e.Channels) != 0 {
+			exclude = nil
+		}
+	}
+
+	entries, err := s.svc.MySQL.GetOutboxSince(e.Sequence, e.Channels, exclude, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*pb.StreamEvent
+	for _, entry := range entries {
+		ev, err := stream.StreamEvent(entry)
+		if err != nil {
+			logrus.WithError(err).Warnf("[Changes] Skipped broken message: %d", entry.Id)
+			continue
+		}
+		events = append(events, ev)
+	}
+
+	return &pb.GetChangesSinceResponse{
+		Events:         events,
+		Truncated:      oldest > e.Sequence+1,
+		LatestSequence: latest,
+	}, nil
+}
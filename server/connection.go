@@ -0,0 +1,88 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/service"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) CheckConnection(ctx context.Context, e *pb.CheckConnectionRequest) (*pb.CheckConnectionResponse, error) {
+	if s.svc.Limiter == nil {
+		return &pb.CheckConnectionResponse{}, errors.New("connection limit not enabled")
+	}
+	if net.ParseIP(e.IpAddress) == nil {
+		return &pb.CheckConnectionResponse{}, errors.New("invalid address")
+	}
+
+	entry, err := s.matchIPFilter(e.IpAddress)
+	if err != nil {
+		return &pb.CheckConnectionResponse{}, err
+	}
+	if entry != nil && entry.Action == database.ALLOW {
+		return &pb.CheckConnectionResponse{Allowed: true, Reason: "FILTER_ALLOW"}, nil
+	} else if entry != nil && entry.Action == database.DENY {
+		resp := &pb.CheckConnectionResponse{Allowed: false, Reason: "FILTER_DENY"}
+		if entry.ExpiresAt != nil {
+			resp.BannedUntil = entry.ExpiresAt.Unix()
+		}
+		return resp, nil
+	}
+
+	r, err := s.svc.Limiter.Hit(e.IpAddress)
+	if err != nil {
+		return &pb.CheckConnectionResponse{}, err
+	}
+	if r.Allowed {
+		return &pb.CheckConnectionResponse{Allowed: true}, nil
+	}
+
+	resp := &pb.CheckConnectionResponse{Allowed: false, Reason: r.Reason}
+	if len(r.Ban) == 0 {
+		logrus.Warnf("[Connection] Rejected %s (%s): %s", e.IpAddress, e.PlayerUUID, r.Reason)
+		return resp, nil
+	}
+
+	expiresAt := time.Now().Add(s.svc.Limiter.BanDuration())
+	ban := &database.IPFilter{
+		Action:      database.DENY,
+		Address:     r.Ban,
+		Description: fmt.Sprintf("Auto ban: %s (%d connections, %s %s)", r.Reason, r.Count, e.IpAddress, e.PlayerUUID),
+		ExpiresAt:   &expiresAt,
+	}
+	if err := s.svc.MySQL.AddIPFilter(ban); err != nil {
+		logrus.WithError(err).Warnf("[Connection] Failed ban %s", r.Ban)
+	} else {
+		logrus.Warnf("[Connection] Banned %s until %s: %s", r.Ban, expiresAt.Format(time.RFC3339), r.Reason)
+		s.reloadIPFilter()
+	}
+	resp.BannedUntil = expiresAt.Unix()
+
+	return resp, nil
+}
+
+// matchIPFilter - Find filter entry for address (exact address or its subnet when IP filter is disabled)
+func (s *grpcServer) matchIPFilter(ip string) (*database.IPFilter, error) {
+	if s.svc.IPFilter != nil {
+		return s.svc.IPFilter.Match(ip), nil
+	}
+
+	prefix, err := service.ParsePrefix(ip)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range []string{prefix.String(), service.SubnetOf(prefix)} {
+		entry, err := s.svc.MySQL.GetIPFilter(address)
+		if err != nil || entry != nil {
+			return entry, err
+		}
+	}
+
+	return nil, nil
+}
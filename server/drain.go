@@ -0,0 +1,217 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+const (
+	drainTimeout       = 60 * time.Second
+	drainPollInterval  = time.Second
+	drainRetryInterval = 5 * time.Second
+)
+
+var errNoFallbackServer = errors.New("no fallback server available")
+
+func (s *grpcServer) DrainServer(ctx context.Context, e *pb.DrainServerRequest) (*pb.DrainServerResponse, error) {
+	entry, err := s.lookupServer(e.Name)
+	if err != nil {
+		return &pb.DrainServerResponse{}, err
+	}
+
+	deadline := time.Now().Add(drainTimeout)
+	if e.Deadline != 0 {
+		deadline = time.Unix(e.Deadline, 0)
+	}
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	if fallbacks, err := s.fallbackServers(e.Name, e.FallbackGroup); err != nil {
+		return &pb.DrainServerResponse{}, err
+	} else if len(fallbacks) == 0 {
+		return &pb.DrainServerResponse{}, errNoFallbackServer
+	}
+
+	err = s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetLockdown(e.Name, true, "&cThis server is restarting"); err != nil {
+			return err
+		}
+		entry, err = tx.GetServerEntry(e.Name)
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.ServerMessage(s.ServerEntry_DBtoPB(entry), entry.Revision))
+	})
+	if err != nil {
+		return &pb.DrainServerResponse{}, err
+	}
+	logrus.Infof("[Drain] Draining %s", e.Name)
+
+	var uuids []string
+	transferred := map[string]bool{}
+	var lastTransfer time.Time
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if time.Since(lastTransfer) >= drainRetryInterval {
+			r, err := s.transferToFallback(e.Name, e.FallbackGroup)
+			if err != nil && !errors.Is(err, errNoFallbackServer) {
+				return &pb.DrainServerResponse{}, err
+			} else if err != nil {
+				logrus.Warnf("[Drain] No free fallback server for %s (%d players transferred)", e.Name, len(r))
+			}
+			for _, uuid := range r {
+				if !transferred[uuid] {
+					transferred[uuid] = true
+					uuids = append(uuids, uuid)
+				}
+			}
+			lastTransfer = time.Now()
+		}
+
+		var remaining int
+		entry, remaining, err = s.drainRemaining(e.Name)
+		if err != nil {
+			return &pb.DrainServerResponse{}, err
+		}
+
+		resp := &pb.DrainServerResponse{
+			Entry:       s.ServerEntry_DBtoPB(entry),
+			PlayerUUIDs: uuids,
+			Completed:   remaining == 0,
+			Remaining:   int32(remaining),
+		}
+		if resp.Completed {
+			logrus.Infof("[Drain] Drained %s (%d players)", e.Name, len(uuids))
+			return resp, nil
+		} else if time.Now().After(deadline) {
+			logrus.Warnf("[Drain] Deadline exceeded: %s (%d players remaining)", e.Name, remaining)
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return &pb.DrainServerResponse{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// drainRemaining - Get players still on the server (known by proxy or reported by pinger)
+func (s *grpcServer) drainRemaining(name string) (database.Servers, int, error) {
+	entry, err := s.svc.MySQL.GetServerEntry(name)
+	if err != nil {
+		return database.Servers{}, 0, err
+	}
+	_, total, err := s.svc.MySQL.GetPlayersOnServer(name, true, 0, 0)
+	if err != nil {
+		return database.Servers{}, 0, err
+	}
+
+	status := database.PingResponse{}
+	json.Unmarshal([]byte(entry.Status), &status)
+
+	remaining := int(total)
+	if int(status.Players.Online) > remaining {
+		remaining = int(status.Players.Online)
+	}
+
+	return entry, remaining, nil
+}
+
+// fallbackServers - Get online and unlocked servers in group (fallback servers when group is empty)
+func (s *grpcServer) fallbackServers(exclude, group string) ([]database.Servers, error) {
+	e, err := s.svc.MySQL.GetAllServerEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []database.Servers
+	for _, v := range e {
+		if v.Name == exclude {
+			continue
+		}
+		if (len(group) == 0 && !v.Fallback) || (len(group) != 0 && v.Group != group) {
+			continue
+		}
+
+		lockdown := database.Lockdown{}
+		json.Unmarshal([]byte(v.Lockdown), &lockdown)
+		status := database.PingResponse{}
+		json.Unmarshal([]byte(v.Status), &status)
+		if lockdown.Enabled || !status.Online {
+			continue
+		}
+
+		servers = append(servers, v)
+	}
+
+	return servers, nil
+}
+
+// transferToFallback - Send players on the server to the least populated fallback servers.
+// When fallback servers are full, players transferred so far are kept and errNoFallbackServer is returned.
+func (s *grpcServer) transferToFallback(name, group string) ([]string, error) {
+	fallbacks, err := s.fallbackServers(name, group)
+	if err != nil {
+		return nil, err
+	}
+
+	online := map[string]int32{}
+	for _, v := range fallbacks {
+		status := database.PingResponse{}
+		json.Unmarshal([]byte(v.Status), &status)
+		online[v.Name] = status.Players.Online
+	}
+
+	var uuids []string
+	full := false
+	err = s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		players, _, err := tx.GetPlayersOnServer(name, true, -1, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, player := range players {
+			target := ""
+			for _, v := range fallbacks {
+				status := database.PingResponse{}
+				json.Unmarshal([]byte(v.Status), &status)
+				if status.Players.Max > 0 && online[v.Name] >= status.Players.Max {
+					continue
+				}
+				if target == "" || online[v.Name] < online[target] {
+					target = v.Name
+				}
+			}
+			if target == "" {
+				// no free slot left: remaining players stay until next retry
+				full = true
+				return nil
+			}
+
+			if err := tx.Enqueue(stream.PlayerTransferMessage(player.ProxyID, player.UUID, target)); err != nil {
+				return err
+			}
+			online[target]++
+			uuids = append(uuids, player.UUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	} else if full {
+		return uuids, errNoFallbackServer
+	}
+
+	return uuids, nil
+}
@@ -21,6 +21,18 @@ import (
 type Services struct {
 	MySQL    *database.Mysql
 	IPFilter *service.IPFilter
+	// IPBans - active IP bans for CheckLogin (DB scan when nil)
+	IPBans  *service.IPBanList
+	Limiter *service.ConnectionLimiter
+	// Addresses - login address storage (raw or hashed)
+	Addresses *service.AddressHasher
+	// Publisher - outbox messages are published with this (stream.MemoryPublisher in tests)
+	Publisher stream.Publisher
+	// Outbox - outbox polling / retention
+	Outbox *stream.OutboxConfig
+
+	// ProxyTimeout - players on proxy are marked as offline when heartbeat stopped (0: disabled)
+	ProxyTimeout time.Duration
 }
 
 type Server interface {
@@ -36,11 +48,14 @@ type grpcServer struct {
 	server Server
 	mu     sync.RWMutex
 	svc    *Services
+	// startedAt - proxies get ProxyTimeout after startup to send heartbeat
+	startedAt time.Time
 }
 
 func NewServer(svc *Services) *grpcServer {
 	return &grpcServer{
-		svc: svc,
+		svc:       svc,
+		startedAt: time.Now(),
 	}
 }
 
@@ -48,6 +63,7 @@ func NewGRPCServer(svc *Services) *grpc.Server {
 	server := grpc.NewServer()
 	newServer := NewServer(svc)
 	pb.RegisterNebulaServer(server, newServer)
+	newServer.startOutbox()
 
 	// Pinging
 	ticker := time.NewTicker(1 * time.Second)
@@ -57,6 +73,8 @@ func NewGRPCServer(svc *Services) *grpc.Server {
 			select {
 			case <-ticker.C:
 				newServer.pinging()
+				newServer.announcing()
+				newServer.reaping()
 			case <-quit:
 				ticker.Stop()
 				return
@@ -66,6 +84,18 @@ func NewGRPCServer(svc *Services) *grpc.Server {
 	return server
 }
 
+// startOutbox - Publish outbox messages with injected publisher
+func (s *grpcServer) startOutbox() chan struct{} {
+	config := s.svc.Outbox
+	if config == nil {
+		config = &stream.OutboxConfig{
+			Interval:  200 * time.Millisecond,
+			Retention: 24 * time.Hour,
+		}
+	}
+	return stream.StartOutbox(s.svc.MySQL, s.svc.Publisher, config)
+}
+
 func (s *grpcServer) GetServerEntry(ctx context.Context, e *pb.GetServerEntryRequest) (*pb.GetServerEntryResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -89,9 +119,13 @@ func (s *grpcServer) AddServerEntry(ctx context.Context, e *pb.AddServerEntryReq
 	defer s.mu.Unlock()
 
 	dbEntry := s.ServerEntry_PBtoDB(e.Entry)
-	err := s.svc.MySQL.AddServerEntry(dbEntry)
-
-	stream.PublishServer(e.Entry)
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		revision, err := tx.AddServerEntry(dbEntry)
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.ServerMessage(e.Entry, revision))
+	})
 
 	return &pb.AddServerEntryResponse{}, err
 }
@@ -100,11 +134,17 @@ func (s *grpcServer) RemoveServerEntry(ctx context.Context, e *pb.RemoveServerEn
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	err := s.svc.MySQL.RemoveServerEntry(e.Name)
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		entry, err := tx.GetServerEntry(e.Name)
+		if err != nil {
+			return err
+		}
+		if err := tx.RemoveServerEntry(e.Name); err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.RemoveServerMessage(&pb.ServerEntry{Name: e.Name}, entry.Revision+1))
+	})
 
-	if err == nil {
-		stream.PublishRemoveServer(&pb.ServerEntry{Name: e.Name})
-	}
 	return &pb.RemoveServerEntryResponse{}, err
 }
 
@@ -114,37 +154,39 @@ func (s *grpcServer) GetBungeeEntry(ctx context.Context, e *pb.GetBungeeEntryReq
 }
 
 func (s *grpcServer) SendBungeeCommand(ctx context.Context, e *pb.SendBungeeCommandRequest) (*pb.SendBungeeCommandResponse, error) {
-	if err := stream.PublishBungeeCommand(e.Command); err != nil {
+	if err := s.svc.MySQL.Enqueue(stream.BungeeCommandMessage(e.Command)); err != nil {
 		return &pb.SendBungeeCommandResponse{}, err
 	}
 	return &pb.SendBungeeCommandResponse{}, nil
 }
 
 func (s *grpcServer) SetMotd(ctx context.Context, e *pb.SetMotdRequest) (*pb.SetMotdResponse, error) {
-	if err := s.svc.MySQL.SetMotd(e.Motd); err != nil {
-		return &pb.SetMotdResponse{}, err
-	}
-	entry, err := s.svc.MySQL.GetBungeeEntry()
-	if err != nil {
-		return &pb.SetMotdResponse{}, err
-	}
-	if err := stream.PublishBungeeEntry(s.BungeeEntry_DBtoPB(entry)); err != nil {
-		return &pb.SetMotdResponse{}, err
-	}
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetMotd(e.Motd); err != nil {
+			return err
+		}
+		entry, err := tx.GetBungeeEntry()
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.BungeeEntryMessage(s.BungeeEntry_DBtoPB(entry), entry.Revision))
+	})
+
 	return &pb.SetMotdResponse{}, err
 }
 
 func (s *grpcServer) SetFavicon(ctx context.Context, e *pb.SetFaviconRequest) (*pb.SetFaviconResponse, error) {
-	if err := s.svc.MySQL.SetFavicon(e.Favicon); err != nil {
-		return &pb.SetFaviconResponse{}, err
-	}
-	entry, err := s.svc.MySQL.GetBungeeEntry()
-	if err != nil {
-		return &pb.SetFaviconResponse{}, err
-	}
-	if err := stream.PublishBungeeEntry(s.BungeeEntry_DBtoPB(entry)); err != nil {
-		return &pb.SetFaviconResponse{}, err
-	}
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetFavicon(e.Favicon); err != nil {
+			return err
+		}
+		entry, err := tx.GetBungeeEntry()
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.BungeeEntryMessage(s.BungeeEntry_DBtoPB(entry), entry.Revision))
+	})
+
 	return &pb.SetFaviconResponse{}, err
 }
 
@@ -152,16 +194,21 @@ func (s *grpcServer) SetLockdown(ctx context.Context, e *pb.SetLockdownRequest)
 	if e.Lockdown.Enabled && e.Lockdown.Description == "" {
 		e.Lockdown.Description = "&cThis server currently not available"
 	}
-	if err := s.svc.MySQL.SetLockdown(e.Name, e.Lockdown.Enabled, e.Lockdown.Description); err != nil {
-		return &pb.SetLockdownResponse{}, err
-	}
-	entry, err := s.svc.MySQL.GetServerEntry(e.Name)
+	var entry database.Servers
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetLockdown(e.Name, e.Lockdown.Enabled, e.Lockdown.Description); err != nil {
+			return err
+		}
+		var err error
+		entry, err = tx.GetServerEntry(e.Name)
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.ServerMessage(s.ServerEntry_DBtoPB(entry), entry.Revision))
+	})
 	if err != nil {
 		return &pb.SetLockdownResponse{}, err
 	}
-	if err := stream.PublishServer(s.ServerEntry_DBtoPB(entry)); err != nil {
-		return &pb.SetLockdownResponse{}, err
-	}
 
 	return &pb.SetLockdownResponse{Entry: s.ServerEntry_DBtoPB(entry)}, err
 }
@@ -179,6 +226,9 @@ func (s *grpcServer) IPLookup(ctx context.Context, e *pb.IPLookupRequest) (*pb.I
 				Isp:          res.ISP,
 				IsSuspicious: res.IsSuspicious,
 				Reason:       res.Reason,
+				LookupFailed: res.LookupFailed,
+				FailClosed:   res.FailClosed,
+				Error:        res.Error,
 			},
 		}, nil
 	} else {
@@ -186,12 +236,48 @@ func (s *grpcServer) IPLookup(ctx context.Context, e *pb.IPLookupRequest) (*pb.I
 	}
 }
 
-func (s *grpcServer) PlayerLogin(ctx context.Context, e *pb.PlayerLoginRequest) (*pb.PlayerLoginResponse, error) {
-	if err := s.svc.MySQL.SyncPlayer(database.PlayersFromProtobuf(e.Profile), &database.UpdateOption{IsQuit: false}); err != nil {
-		return &pb.PlayerLoginResponse{}, err
+func (s *grpcServer) GetIPLookupStats(ctx context.Context, e *pb.GetIPLookupStatsRequest) (*pb.GetIPLookupStatsResponse, error) {
+	if s.svc.IPFilter == nil {
+		return &pb.GetIPLookupStatsResponse{}, errors.New("iplookup not enabled")
 	}
 
-	if err := stream.PublishPlayerProfile(nebulapb.PlayerPropertiesStream_JOIN_SOLO, e.Profile); err != nil {
+	stats := s.svc.IPFilter.CacheStats()
+	return &pb.GetIPLookupStatsResponse{
+		Hits:         stats.Hits,
+		StoreHits:    stats.StoreHits,
+		NegativeHits: stats.NegativeHits,
+		Misses:       stats.Misses,
+		Shared:       stats.Shared,
+		Size:         int64(stats.Size),
+		Evictions:    stats.Evictions,
+	}, nil
+}
+
+func (s *grpcServer) PlayerLogin(ctx context.Context, e *pb.PlayerLoginRequest) (*pb.PlayerLoginResponse, error) {
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		newPlayer := database.PlayersFromProtobuf(e.Profile)
+		newPlayer.ProxyID = e.ProxyId
+		if err := tx.SyncPlayer(newPlayer, &database.UpdateOption{IsQuit: false}); err != nil {
+			return err
+		}
+		if err := tx.OpenPlayerSession(e.Profile.PlayerUUID, e.Profile.PlayerName, e.Profile.CurrentServer, true); err != nil {
+			return err
+		}
+		if len(e.IpAddress) != 0 {
+			address, subnet, err := s.svc.Addresses.Hash(e.IpAddress)
+			if err != nil {
+				logrus.WithError(err).Warnf("[Player] Invalid login address: %s", e.Profile.PlayerUUID)
+			} else if err := tx.RecordPlayerAddress(e.Profile.PlayerUUID, e.Profile.PlayerName, address, subnet); err != nil {
+				return err
+			}
+		}
+		player, err := tx.GetPlayer(e.Profile.PlayerUUID)
+		if err != nil {
+			return err
+		}
+		return stream.EnqueuePlayer(tx, stream.PlayerProfileMessage(nebulapb.PlayerPropertiesStream_JOIN_SOLO, player.ToProtobuf(), player.Revision))
+	})
+	if err != nil {
 		return &pb.PlayerLoginResponse{}, err
 	}
 
@@ -199,20 +285,64 @@ func (s *grpcServer) PlayerLogin(ctx context.Context, e *pb.PlayerLoginRequest)
 }
 
 func (s *grpcServer) PlayerQuit(ctx context.Context, e *pb.PlayerQuitRequest) (*pb.PlayerQuitResponse, error) {
-	err := s.svc.MySQL.SyncPlayer(database.PlayersFromProtobuf(e.Profile), &database.UpdateOption{IsQuit: true})
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SyncPlayer(database.PlayersFromProtobuf(e.Profile), &database.UpdateOption{IsQuit: true}); err != nil {
+			return err
+		}
+		if err := tx.ClosePlayerSession(e.Profile.PlayerUUID, true); err != nil {
+			return err
+		}
+		player, err := tx.GetPlayer(e.Profile.PlayerUUID)
+		if err != nil {
+			return err
+		}
+		e.Profile.Hide = player.Hide
+		return stream.EnqueuePlayer(tx, stream.PlayerProfileMessage(nebulapb.PlayerPropertiesStream_QUIT_SOLO, e.Profile, player.Revision))
+	})
 	if err != nil {
 		return &pb.PlayerQuitResponse{}, err
 	}
 
-	if err := stream.PublishPlayerProfile(nebulapb.PlayerPropertiesStream_QUIT_SOLO, e.Profile); err != nil {
-		return &pb.PlayerQuitResponse{}, err
+	return &pb.PlayerQuitResponse{}, nil
+}
+
+func (s *grpcServer) PlayerSwitchServer(ctx context.Context, e *pb.PlayerSwitchServerRequest) (*pb.PlayerSwitchServerResponse, error) {
+	if len(e.PlayerUUID) == 0 || len(e.ToServer) == 0 {
+		return &pb.PlayerSwitchServerResponse{}, errors.New("playerUUID or toServer is empty")
 	}
 
-	return &pb.PlayerQuitResponse{}, nil
+	var player database.Players
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		prev, err := tx.GetPlayer(e.PlayerUUID)
+		if err != nil {
+			return err
+		}
+		fromServer := e.FromServer
+		if len(fromServer) == 0 {
+			fromServer = prev.CurrentServer
+		}
+
+		if err := tx.SwitchPlayerServer(e.PlayerUUID, e.ToServer); err != nil {
+			return err
+		}
+		player, err = tx.GetPlayer(e.PlayerUUID)
+		if err != nil {
+			return err
+		}
+		if err := tx.OpenPlayerSession(player.UUID, player.Name, player.CurrentServer, false); err != nil {
+			return err
+		}
+		return stream.EnqueuePlayer(tx, stream.PlayerSwitchMessage(player.ToProtobuf(), fromServer, player.Revision))
+	})
+	if err != nil {
+		return &pb.PlayerSwitchServerResponse{}, err
+	}
+
+	return &pb.PlayerSwitchServerResponse{Profile: player.ToProtobuf()}, nil
 }
 
 func (s *grpcServer) FetchAllPlayers(ctx context.Context, e *pb.FetchAllPlayersRequest) (*pb.FetchAllPlayersResponse, error) {
-	r, err := s.svc.MySQL.GetAllPlayers()
+	r, err := s.svc.MySQL.GetAllPlayers(e.IncludeHidden)
 	if err != nil {
 		return nil, err
 	}
@@ -228,34 +358,152 @@ func (s *grpcServer) FetchAllPlayers(ctx context.Context, e *pb.FetchAllPlayersR
 }
 
 func (s *grpcServer) UpdateAllPlayers(ctx context.Context, e *pb.UpdateAllPlayersRequest) (*pb.UpdateAllPlayersResponse, error) {
-	var profiles []database.Players
-
-	if len(e.Profiles) == 0 {
-		return &pb.UpdateAllPlayersResponse{}, nil
+	resp := &pb.UpdateAllPlayersResponse{}
+	if len(e.Profiles) == 0 && len(e.ProxyId) == 0 {
+		return resp, nil
 	}
 
-	if err := stream.PublicAllPlayerProfile(nebulapb.PlayerPropertiesStream_ADVERTISE_ALL, e.Profiles); err != nil {
-		return &pb.UpdateAllPlayersResponse{}, err
-	}
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		var uuids []string
+		for _, profile := range e.Profiles {
+			uuids = append(uuids, profile.PlayerUUID)
+		}
+
+		current := map[string]database.Players{}
+		if len(uuids) != 0 {
+			players, err := tx.GetPlayers(uuids)
+			if err != nil {
+				return err
+			}
+			for _, p := range players {
+				current[p.UUID] = p
+			}
+		}
 
-	for _, profile := range e.Profiles {
-		profiles = append(profiles, *database.PlayersFromProtobuf(profile))
+		// diff
+		var changed []database.Players
+		types := map[string]pb.PlayerPropertiesStream_Type{}
+		fromServers := map[string]string{}
+		listed := map[string]bool{}
+		for _, profile := range e.Profiles {
+			listed[profile.PlayerUUID] = true
+			newPlayer := database.PlayersFromProtobuf(profile)
+			newPlayer.ProxyID = e.ProxyId
+
+			cur, ok := current[profile.PlayerUUID]
+			if len(e.ProxyId) == 0 {
+				// legacy proxy: keep proxy set by PlayerLogin
+				newPlayer.ProxyID = cur.ProxyID
+			}
+			switch {
+			case !ok || cur.CurrentServer == "" || (len(e.ProxyId) != 0 && cur.ProxyID != e.ProxyId):
+				types[newPlayer.UUID] = pb.PlayerPropertiesStream_JOIN_SOLO
+			case cur.CurrentServer != newPlayer.CurrentServer:
+				types[newPlayer.UUID] = pb.PlayerPropertiesStream_SWITCH_SOLO
+				fromServers[newPlayer.UUID] = cur.CurrentServer
+			case cur.Name != newPlayer.Name || cur.Latency != newPlayer.Latency || cur.RawProperties != newPlayer.RawProperties:
+				types[newPlayer.UUID] = pb.PlayerPropertiesStream_UPDATE_SOLO
+			default:
+				continue
+			}
+			changed = append(changed, *newPlayer)
+		}
+
+		var quits []database.Players
+		if len(e.ProxyId) != 0 {
+			onProxy, err := tx.GetPlayersOnProxy(e.ProxyId)
+			if err != nil {
+				return err
+			}
+			for _, p := range onProxy {
+				if !listed[p.UUID] {
+					quits = append(quits, p)
+				}
+			}
+		}
+
+		// apply
+		if len(changed) != 0 {
+			if err := tx.UpdateAllPlayers(changed); err != nil {
+				return err
+			}
+		}
+		for _, p := range quits {
+			if err := tx.SetPlayerOffline(p.UUID); err != nil {
+				return err
+			}
+			if err := tx.ClosePlayerSession(p.UUID, true); err != nil {
+				return err
+			}
+		}
+
+		// publish delta
+		var changedUUIDs []string
+		for _, p := range changed {
+			changedUUIDs = append(changedUUIDs, p.UUID)
+		}
+		if len(changedUUIDs) != 0 {
+			players, err := tx.GetPlayers(changedUUIDs)
+			if err != nil {
+				return err
+			}
+			// sessions first: outbox sequence lock is taken last (same order as PlayerLogin)
+			for _, p := range players {
+				switch types[p.UUID] {
+				case pb.PlayerPropertiesStream_JOIN_SOLO, pb.PlayerPropertiesStream_SWITCH_SOLO:
+					if err := tx.OpenPlayerSession(p.UUID, p.Name, p.CurrentServer, types[p.UUID] == pb.PlayerPropertiesStream_JOIN_SOLO); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, p := range players {
+				var msg *pb.PlayerPropertiesStream
+				switch types[p.UUID] {
+				case pb.PlayerPropertiesStream_JOIN_SOLO:
+					resp.Joined++
+					msg = stream.PlayerProfileMessage(pb.PlayerPropertiesStream_JOIN_SOLO, p.ToProtobuf(), p.Revision)
+				case pb.PlayerPropertiesStream_SWITCH_SOLO:
+					resp.Switched++
+					msg = stream.PlayerSwitchMessage(p.ToProtobuf(), fromServers[p.UUID], p.Revision)
+				default:
+					resp.Updated++
+					msg = stream.PlayerProfileMessage(pb.PlayerPropertiesStream_UPDATE_SOLO, p.ToProtobuf(), p.Revision)
+				}
+				if err := stream.EnqueuePlayer(tx, msg); err != nil {
+					return err
+				}
+			}
+		}
+		for _, p := range quits {
+			resp.Quit++
+			if err := stream.EnqueuePlayer(tx, stream.PlayerProfileMessage(pb.PlayerPropertiesStream_QUIT_SOLO, p.ToProtobuf(), p.Revision+1)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return &pb.UpdateAllPlayersResponse{}, err
 	}
 
-	return &pb.UpdateAllPlayersResponse{}, s.svc.MySQL.UpdateAllPlayers(profiles)
+	return resp, nil
 }
 
 func (s *grpcServer) BungeeEntry_DBtoPB(dbEntry database.Bungee) *pb.BungeeEntry {
 	return &pb.BungeeEntry{
-		Motd:    dbEntry.Motd,
-		Favicon: dbEntry.Favicon,
+		Motd:      dbEntry.Motd,
+		Favicon:   dbEntry.Favicon,
+		Whitelist: dbEntry.Whitelist,
 	}
 }
 
 func (s *grpcServer) BungeeEntry_PBtoDB(pbEntry *pb.BungeeEntry) database.Bungee {
 	return database.Bungee{
-		Motd:    pbEntry.Motd,
-		Favicon: pbEntry.Favicon,
+		Motd:      pbEntry.Motd,
+		Favicon:   pbEntry.Favicon,
+		Whitelist: pbEntry.Whitelist,
 	}
 }
 
@@ -318,6 +566,7 @@ func (s *grpcServer) ServerEntry_DBtoPB(dbEntry database.Servers) *pb.ServerEntr
 		Fallback:    dbEntry.Fallback,
 		Lockdown:    s.Lockdown_DBtoPB(lockdown),
 		Status:      s.Status_DBtoPB(status),
+		Group:       dbEntry.Group,
 	}
 }
 
@@ -332,5 +581,6 @@ func (s *grpcServer) ServerEntry_PBtoDB(pbEntry *pb.ServerEntry) database.Server
 		Motd:        pbEntry.Motd,
 		Fallback:    pbEntry.Fallback,
 		Lockdown:    string(r),
+		Group:       pbEntry.Group,
 	}
 }
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+// newTestServer - Server with in-memory publisher (requires NEBULA_TEST_MYSQL, e.g. root:docker@tcp(localhost:3306)/nebula_test?parseTime=True)
+func newTestServer(t *testing.T) (*grpcServer, *stream.MemoryPublisher, chan struct{}) {
+	t.Helper()
+
+	conStr := os.Getenv("NEBULA_TEST_MYSQL")
+	if len(conStr) == 0 {
+		t.Skip("NEBULA_TEST_MYSQL is not set")
+	}
+
+	publisher := stream.NewMemoryPublisher()
+	s := NewServer(&Services{
+		MySQL:     database.NewMysqlClient(conStr, "nebula_test"),
+		Publisher: publisher,
+		Outbox: &stream.OutboxConfig{
+			Interval:  10 * time.Millisecond,
+			Retention: time.Hour,
+		},
+	})
+	return s, publisher, s.startOutbox()
+}
+
+// waitServerStream - Wait until server entry message is published
+func waitServerStream(t *testing.T, publisher *stream.MemoryPublisher, name string, streamType pb.ServerEntryStream_Type) *pb.ServerEntryStream {
+	t.Helper()
+
+	// lease of previous test run may be held until it expires
+	deadline := time.Now().Add(40 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, m := range publisher.Messages(stream.ServerChannel) {
+			msg := m.Message.(*pb.ServerEntryStream)
+			if msg.Type == streamType && msg.Entry.GetName() == name {
+				return msg
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("%s of %s was not published", streamType, name)
+	return nil
+}
+
+func TestServerEntryPublish(t *testing.T) {
+	s, publisher, quit := newTestServer(t)
+	defer close(quit)
+	ctx := context.Background()
+	name := fmt.Sprintf("test-%d", time.Now().UnixNano())
+
+	if _, err := s.AddServerEntry(ctx, &pb.AddServerEntryRequest{Entry: &pb.ServerEntry{Name: name, Address: "localhost", Port: 25565}}); err != nil {
+		t.Fatal(err)
+	}
+	added := waitServerStream(t, publisher, name, pb.ServerEntryStream_SYNC)
+	if added.Revision != 1 || added.Sequence == 0 {
+		t.Errorf("add: revision = %d, sequence = %d", added.Revision, added.Sequence)
+	}
+
+	if _, err := s.RemoveServerEntry(ctx, &pb.RemoveServerEntryRequest{Name: name}); err != nil {
+		t.Fatal(err)
+	}
+	removed := waitServerStream(t, publisher, name, pb.ServerEntryStream_REMOVE)
+	if removed.Revision != 2 || removed.Sequence <= added.Sequence {
+		t.Errorf("remove: revision = %d, sequence = %d", removed.Revision, removed.Sequence)
+	}
+
+	// re-added server continues revision
+	publisher.Reset()
+	if _, err := s.AddServerEntry(ctx, &pb.AddServerEntryRequest{Entry: &pb.ServerEntry{Name: name}}); err != nil {
+		t.Fatal(err)
+	}
+	if readded := waitServerStream(t, publisher, name, pb.ServerEntryStream_SYNC); readded.Revision != 3 {
+		t.Errorf("re-add: revision = %d, want 3", readded.Revision)
+	}
+	s.RemoveServerEntry(ctx, &pb.RemoveServerEntryRequest{Name: name})
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/service"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) AddIPFilter(ctx context.Context, e *pb.AddIPFilterRequest) (*pb.AddIPFilterResponse, error) {
+	if e.Entry == nil {
+		return &pb.AddIPFilterResponse{}, errors.New("entry is empty")
+	}
+	prefix, err := service.ParsePrefix(e.Entry.Address)
+	if err != nil {
+		return &pb.AddIPFilterResponse{}, err
+	}
+
+	entry := database.IPFilterFromProtobuf(e.Entry)
+	entry.Id = 0
+	entry.Address = prefix.String()
+	if err := s.svc.MySQL.AddIPFilter(entry); err != nil {
+		return &pb.AddIPFilterResponse{}, err
+	}
+	s.reloadIPFilter()
+
+	return &pb.AddIPFilterResponse{Entry: entry.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) RemoveIPFilter(ctx context.Context, e *pb.RemoveIPFilterRequest) (*pb.RemoveIPFilterResponse, error) {
+	address := e.Address
+	if prefix, err := service.ParsePrefix(address); err == nil {
+		address = prefix.String()
+	}
+
+	if err := s.svc.MySQL.RemoveIPFilter(address); err != nil {
+		return &pb.RemoveIPFilterResponse{}, err
+	}
+	s.reloadIPFilter()
+
+	return &pb.RemoveIPFilterResponse{}, nil
+}
+
+func (s *grpcServer) ListIPFilters(ctx context.Context, e *pb.ListIPFiltersRequest) (*pb.ListIPFiltersResponse, error) {
+	r, err := s.svc.MySQL.ListIPFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.IPFilterEntry
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.ListIPFiltersResponse{
+		Entries: resp,
+	}, nil
+}
+
+// reloadIPFilter - Apply filter changes to IPLookup immediately
+func (s *grpcServer) reloadIPFilter() {
+	if s.svc.IPFilter == nil {
+		return
+	}
+	if err := s.svc.IPFilter.Reload(); err != nil {
+		logrus.WithError(err).Errorf("[IPFilter] Failed reload filter")
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/sirupsen/logrus"
 	"github.com/synchthia/nebula-api/database"
@@ -19,6 +20,7 @@ func (s *grpcServer) pinging() {
 			logrus.Debugf("Trying Entry: %s", v.Name)
 			go func(data database.Servers) {
 				//s.mu.Lock()
+				prevStatus := data.Status
 				r, pingErr := util.Ping(data.Address + ":" + fmt.Sprint(data.Port))
 
 				if r != nil && pingErr == nil {
@@ -30,16 +32,37 @@ func (s *grpcServer) pinging() {
 					statusJson, _ := json.Marshal(database.PingResponse{})
 					data.Status = string(statusJson)
 				}
-				_, _, pushErr := s.svc.MySQL.PushServerStatus(data.Name, data.Status)
-				//s.mu.Unlock()
-				if pushErr != nil {
+				if !statusChanged(prevStatus, data.Status) {
 					return
 				}
 
-				// TODO: Currently, stream status anyway. (due to mongodb recovering.)
-				// Needed: MongoDB recover detection & Automatic publish to redis.
-				stream.PublishServer(s.ServerEntry_DBtoPB(data))
+				pushErr := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+					if _, _, err := tx.PushServerStatus(data.Name, data.Status); err != nil {
+						return err
+					}
+					entry, err := tx.GetServerEntry(data.Name)
+					if err != nil {
+						return err
+					}
+					return tx.Enqueue(stream.ServerMessage(s.ServerEntry_DBtoPB(entry), entry.Revision))
+				})
+				//s.mu.Unlock()
+				if pushErr != nil {
+					logrus.WithError(pushErr).Errorf("[Pinging] Failed push status: %s", data.Name)
+				}
 			}(v)
 		}
 	}
 }
+
+// statusChanged - compare status json semantically (MySQL normalizes json columns)
+func statusChanged(prev, next string) bool {
+	var p, n interface{}
+	if err := json.Unmarshal([]byte(prev), &p); err != nil {
+		return true
+	}
+	if err := json.Unmarshal([]byte(next), &n); err != nil {
+		return true
+	}
+	return !reflect.DeepEqual(p, n)
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+var (
+	errPlayerNotFound  = errors.New("player not found")
+	errPlayerNotOnline = errors.New("player is not online")
+)
+
+// lookupPlayer - Find player by UUID, then by name
+func (s *grpcServer) lookupPlayer(uuid, name string, includeHidden bool) (database.Players, error) {
+	var player database.Players
+	err := gorm.ErrRecordNotFound
+	if len(uuid) != 0 {
+		player, err = s.svc.MySQL.GetPlayer(uuid)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) && len(name) != 0 {
+		player, err = s.svc.MySQL.GetPlayerByName(name)
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && player.Hide && !includeHidden) {
+		return database.Players{}, errPlayerNotFound
+	} else if err != nil {
+		return database.Players{}, err
+	}
+
+	return player, nil
+}
+
+// visibleProfile - Hidden player looks offline unless includeHidden
+func visibleProfile(player database.Players, includeHidden bool) *pb.PlayerProfile {
+	profile := player.ToProtobuf()
+	if player.Hide && !includeHidden {
+		profile.CurrentServer = ""
+		profile.PlayerLatency = 0
+		profile.Hide = false
+	}
+	return profile
+}
+
+func (s *grpcServer) GetPlayer(ctx context.Context, e *pb.GetPlayerRequest) (*pb.GetPlayerResponse, error) {
+	player, err := s.svc.MySQL.GetPlayer(e.PlayerUUID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.GetPlayerResponse{}, errPlayerNotFound
+	} else if err != nil {
+		return &pb.GetPlayerResponse{}, err
+	}
+
+	return &pb.GetPlayerResponse{Profile: visibleProfile(player, e.IncludeHidden)}, nil
+}
+
+func (s *grpcServer) FindPlayer(ctx context.Context, e *pb.FindPlayerRequest) (*pb.FindPlayerResponse, error) {
+	if len(e.Query) == 0 {
+		return &pb.FindPlayerResponse{}, errors.New("query is empty")
+	}
+
+	player, err := s.lookupPlayer(e.Query, e.Query, e.IncludeHidden)
+	if err != nil {
+		return &pb.FindPlayerResponse{}, err
+	}
+
+	return &pb.FindPlayerResponse{
+		Profile: player.ToProtobuf(),
+		Online:  player.CurrentServer != "",
+		ProxyId: player.ProxyID,
+	}, nil
+}
+
+func (s *grpcServer) SendPlayerMessage(ctx context.Context, e *pb.SendPlayerMessageRequest) (*pb.SendPlayerMessageResponse, error) {
+	if len(e.PlayerUUID) == 0 && len(e.PlayerName) == 0 {
+		return &pb.SendPlayerMessageResponse{}, errors.New("playerUUID or playerName is required")
+	}
+
+	player, err := s.lookupPlayer(e.PlayerUUID, e.PlayerName, e.IncludeHidden)
+	if err != nil {
+		return &pb.SendPlayerMessageResponse{}, err
+	} else if player.CurrentServer == "" {
+		return &pb.SendPlayerMessageResponse{}, errPlayerNotOnline
+	}
+
+	if err := s.svc.MySQL.Enqueue(stream.PlayerMessageMessage(player.ProxyID, player.UUID, e.Message, e.SenderUUID, e.SenderName)); err != nil {
+		return &pb.SendPlayerMessageResponse{}, err
+	}
+
+	return &pb.SendPlayerMessageResponse{Profile: player.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) SetPlayerHidden(ctx context.Context, e *pb.SetPlayerHiddenRequest) (*pb.SetPlayerHiddenResponse, error) {
+	var player database.Players
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetPlayerHidden(e.PlayerUUID, e.Hidden); err != nil {
+			return err
+		}
+		var err error
+		player, err = tx.GetPlayer(e.PlayerUUID)
+		if err != nil {
+			return err
+		}
+
+		profile := player.ToProtobuf()
+		if err := tx.Enqueue(stream.PlayerStaffChannel, stream.PlayerProfileMessage(pb.PlayerPropertiesStream_UPDATE_SOLO, profile, player.Revision)); err != nil {
+			return err
+		}
+		if player.CurrentServer == "" {
+			return nil
+		}
+
+		// vanish: looks like quit / join for other players
+		t := pb.PlayerPropertiesStream_JOIN_SOLO
+		if e.Hidden {
+			t = pb.PlayerPropertiesStream_QUIT_SOLO
+		}
+		public := proto.Clone(profile).(*pb.PlayerProfile)
+		public.Hide = false
+		return tx.Enqueue(stream.PlayerChannel, stream.PlayerProfileMessage(t, public, player.Revision))
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.SetPlayerHiddenResponse{}, errPlayerNotFound
+	} else if err != nil {
+		return &pb.SetPlayerHiddenResponse{}, err
+	}
+
+	return &pb.SetPlayerHiddenResponse{Profile: player.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) GetPlayerByName(ctx context.Context, e *pb.GetPlayerByNameRequest) (*pb.GetPlayerByNameResponse, error) {
+	if len(e.PlayerName) == 0 {
+		return &pb.GetPlayerByNameResponse{}, errors.New("playerName is empty")
+	}
+
+	player, err := s.svc.MySQL.GetPlayerByName(e.PlayerName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.GetPlayerByNameResponse{}, errPlayerNotFound
+	} else if err != nil {
+		return &pb.GetPlayerByNameResponse{}, err
+	}
+
+	return &pb.GetPlayerByNameResponse{Profile: visibleProfile(player, e.IncludeHidden)}, nil
+}
+
+func (s *grpcServer) ListPlayersOnServer(ctx context.Context, e *pb.ListPlayersOnServerRequest) (*pb.ListPlayersOnServerResponse, error) {
+	if len(e.Name) == 0 {
+		return &pb.ListPlayersOnServerResponse{}, errors.New("name is empty")
+	}
+
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 1000 {
+		limit = 1000
+	}
+
+	r, total, err := s.svc.MySQL.GetPlayersOnServer(e.Name, e.IncludeHidden, limit, int(e.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.PlayerProfile
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.ListPlayersOnServerResponse{
+		Profiles: resp,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) ProxyHeartbeat(ctx context.Context, e *pb.ProxyHeartbeatRequest) (*pb.ProxyHeartbeatResponse, error) {
+	if len(e.ProxyId) == 0 {
+		return &pb.ProxyHeartbeatResponse{}, errors.New("proxyId is empty")
+	}
+
+	err := s.svc.MySQL.ProxyHeartbeat(e.ProxyId)
+	return &pb.ProxyHeartbeatResponse{}, err
+}
+
+func (s *grpcServer) ResetProxy(ctx context.Context, e *pb.ResetProxyRequest) (*pb.ResetProxyResponse, error) {
+	if len(e.ProxyId) == 0 {
+		return &pb.ResetProxyResponse{}, errors.New("proxyId is empty")
+	}
+
+	count := 0
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		var err error
+		count, err = reapPlayers(tx, e.ProxyId, time.Now())
+		return err
+	})
+	if err != nil {
+		return &pb.ResetProxyResponse{}, err
+	}
+
+	logrus.Infof("[Proxy] Reset %s: %d players marked as offline", e.ProxyId, count)
+	return &pb.ResetProxyResponse{Players: int32(count)}, nil
+}
+
+// reaping - Mark players on silent proxies as offline
+func (s *grpcServer) reaping() {
+	if s.svc.ProxyTimeout <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-s.svc.ProxyTimeout)
+	if before.Before(s.startedAt) {
+		// heartbeats were not received while API was down: wait for them
+		return
+	}
+
+	proxies, err := s.svc.MySQL.GetStaleProxies(before)
+	if err != nil {
+		logrus.Errorf("[Database] Error %s", err)
+		return
+	}
+
+	for _, proxy := range proxies {
+		count := 0
+		err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+			removed, err := tx.RemoveStaleProxy(proxy.Id, before)
+			if err != nil || !removed {
+				return err
+			}
+
+			count, err = reapPlayers(tx, proxy.Id, proxy.LastSeen)
+			return err
+		})
+		if err != nil {
+			logrus.WithError(err).Errorf("[Proxy] Failed reap proxy: %s", proxy.Id)
+			continue
+		}
+
+		logrus.Warnf("[Proxy] %s went silent (last seen: %s): %d players marked as offline", proxy.Id, proxy.LastSeen, count)
+	}
+}
+
+// reapPlayers - Mark all players on proxy as offline and publish quit
+func reapPlayers(tx *database.Mysql, proxyID string, at time.Time) (int, error) {
+	players, err := tx.GetPlayersOnProxy(proxyID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, player := range players {
+		if err := tx.SetPlayerOffline(player.UUID); err != nil {
+			return 0, err
+		}
+		if err := tx.ClosePlayerSessionAt(player.UUID, true, at); err != nil {
+			return 0, err
+		}
+	}
+
+	// enqueue last: outbox sequence lock is taken after player / session rows (same order as PlayerLogin)
+	for _, player := range players {
+		if err := stream.EnqueuePlayer(tx, stream.PlayerProfileMessage(pb.PlayerPropertiesStream_QUIT_SOLO, player.ToProtobuf(), player.Revision+1)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(players), nil
+}
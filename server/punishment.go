@@ -0,0 +1,251 @@
+package server
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/service"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+	"gorm.io/gorm"
+)
+
+var errPunishmentNotFound = errors.New("punishment not found")
+
+func (s *grpcServer) Punish(ctx context.Context, e *pb.PunishRequest) (*pb.PunishResponse, error) {
+	if e.Punishment == nil {
+		return &pb.PunishResponse{}, errors.New("punishment is empty")
+	}
+
+	punishment := database.PunishmentsFromProtobuf(e.Punishment)
+	punishment.Id = 0
+	switch e.Punishment.Type {
+	case pb.Punishment_IPBAN:
+		prefix, err := service.ParsePrefix(punishment.Address)
+		if err != nil {
+			return &pb.PunishResponse{}, errors.New("invalid address")
+		}
+		punishment.Address = prefix.String()
+	case pb.Punishment_BAN, pb.Punishment_TEMPBAN, pb.Punishment_MUTE, pb.Punishment_WARN:
+		if len(punishment.UUID) == 0 {
+			return &pb.PunishResponse{}, errors.New("playerUUID is empty")
+		}
+	default:
+		return &pb.PunishResponse{}, errors.New("unknown punishment type")
+	}
+	if e.Punishment.Type == pb.Punishment_TEMPBAN && (punishment.ExpiresAt == nil || punishment.ExpiresAt.Before(time.Now())) {
+		return &pb.PunishResponse{}, errors.New("expiresAt is required for tempban")
+	}
+
+	var kicked []string
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if len(punishment.UUID) != 0 && len(punishment.Name) == 0 {
+			if player, err := tx.GetPlayer(punishment.UUID); err == nil {
+				punishment.Name = player.Name
+			}
+		}
+		if err := tx.AddPunishment(punishment); err != nil {
+			return err
+		}
+
+		data := punishment.ToProtobuf()
+		if err := tx.Enqueue(stream.PunishmentMessage(pb.PunishmentStream_PUNISH, data)); err != nil {
+			return err
+		}
+
+		// ban: kick wherever the player is
+		if data.Type != pb.Punishment_BAN && data.Type != pb.Punishment_TEMPBAN && data.Type != pb.Punishment_IPBAN {
+			return nil
+		}
+		targets, err := s.punishmentTargets(tx, punishment)
+		if err != nil {
+			return err
+		}
+		for _, player := range targets {
+			if err := tx.Enqueue(stream.PlayerKickMessage(player.ProxyID, player.UUID, data.Reason, data)); err != nil {
+				return err
+			}
+			kicked = append(kicked, player.UUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return &pb.PunishResponse{}, err
+	}
+	if punishment.Type == int32(pb.Punishment_IPBAN) {
+		s.reloadIPBans()
+	}
+
+	target := punishment.Name
+	if len(target) == 0 {
+		target = punishment.Address
+	}
+	logrus.Infof("[Punishment] %s %s by %s: %s", pb.Punishment_Type(punishment.Type), target, punishment.ActorName, punishment.Reason)
+	return &pb.PunishResponse{Punishment: punishment.ToProtobuf(), Kicked: kicked}, nil
+}
+
+// punishmentTargets - Get online players affected by the punishment.
+// IP ban matches online players whose last login address is in the banned prefix (and the banned player).
+func (s *grpcServer) punishmentTargets(tx *database.Mysql, punishment *database.Punishments) ([]database.Players, error) {
+	if punishment.Type != int32(pb.Punishment_IPBAN) {
+		player, err := tx.GetPlayer(punishment.UUID)
+		if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && player.CurrentServer == "") {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return []database.Players{player}, nil
+	}
+
+	prefix, err := service.ParsePrefix(punishment.Address)
+	if err != nil {
+		return nil, err
+	}
+	if ones, bits := prefix.Mask.Size(); s.svc.Addresses.Hashed() && ones != bits && service.SubnetOf(prefix) != prefix.String() {
+		logrus.Warnf("[Punishment] Addresses are hashed, online players in %s are not kicked", prefix)
+	}
+
+	players, err := tx.GetAllPlayers(true)
+	if err != nil || len(players) == 0 {
+		return nil, err
+	}
+	var uuids []string
+	for _, player := range players {
+		uuids = append(uuids, player.UUID)
+	}
+	latest, err := tx.GetLatestPlayerAddresses(uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []database.Players
+	for _, player := range players {
+		if a, ok := latest[player.UUID]; player.UUID == punishment.UUID || (ok && s.svc.Addresses.Match(prefix, a.Address, a.Subnet)) {
+			targets = append(targets, player)
+		}
+	}
+	return targets, nil
+}
+
+func (s *grpcServer) Revoke(ctx context.Context, e *pb.RevokeRequest) (*pb.RevokeResponse, error) {
+	by := e.ActorName
+	if len(by) == 0 {
+		by = e.ActorUUID
+	}
+
+	var punishment database.Punishments
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		revoked, err := tx.RevokePunishment(e.Id, by, e.Reason)
+		if err != nil {
+			return err
+		}
+		punishment, err = tx.GetPunishment(e.Id)
+		if err != nil {
+			return err
+		} else if !revoked {
+			return errors.New("already revoked")
+		}
+
+		return tx.Enqueue(stream.PunishmentMessage(pb.PunishmentStream_REVOKE, punishment.ToProtobuf()))
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pb.RevokeResponse{}, errPunishmentNotFound
+	} else if err != nil {
+		return &pb.RevokeResponse{}, err
+	}
+	if punishment.Type == int32(pb.Punishment_IPBAN) {
+		s.reloadIPBans()
+	}
+
+	return &pb.RevokeResponse{Punishment: punishment.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) GetPunishments(ctx context.Context, e *pb.GetPunishmentsRequest) (*pb.GetPunishmentsResponse, error) {
+	if len(e.PlayerUUID) == 0 && len(e.Address) == 0 {
+		return &pb.GetPunishmentsResponse{}, errors.New("playerUUID or address is required")
+	}
+
+	address := e.Address
+	if prefix, err := service.ParsePrefix(address); err == nil {
+		address = prefix.String()
+	}
+
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	r, err := s.svc.MySQL.GetPunishments(e.PlayerUUID, address, e.IncludeInactive, limit, int(e.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.Punishment
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.GetPunishmentsResponse{
+		Punishments: resp,
+	}, nil
+}
+
+func (s *grpcServer) CheckLogin(ctx context.Context, e *pb.CheckLoginRequest) (*pb.CheckLoginResponse, error) {
+	if len(e.PlayerUUID) == 0 {
+		return &pb.CheckLoginResponse{}, errors.New("playerUUID is empty")
+	}
+
+	bans, err := s.svc.MySQL.GetActivePunishments(e.PlayerUUID, pb.Punishment_BAN, pb.Punishment_TEMPBAN)
+	if err != nil {
+		return &pb.CheckLoginResponse{}, err
+	} else if len(bans) != 0 {
+		return &pb.CheckLoginResponse{Allowed: false, Reason: "BANNED", Punishment: bans[0].ToProtobuf()}, nil
+	}
+
+	if s.svc.IPBans != nil {
+		if ban := s.svc.IPBans.Match(e.IpAddress); ban != nil {
+			return &pb.CheckLoginResponse{Allowed: false, Reason: "IP_BANNED", Punishment: ban.ToProtobuf()}, nil
+		}
+	} else if ip := net.ParseIP(e.IpAddress); ip != nil {
+		bans, err := s.svc.MySQL.GetActiveIPBans()
+		if err != nil {
+			return &pb.CheckLoginResponse{}, err
+		}
+		for _, ban := range bans {
+			if prefix, err := service.ParsePrefix(ban.Address); err == nil && prefix.Contains(ip) {
+				return &pb.CheckLoginResponse{Allowed: false, Reason: "IP_BANNED", Punishment: ban.ToProtobuf()}, nil
+			}
+		}
+	}
+
+	bungee, err := s.svc.MySQL.GetBungeeEntry()
+	if err != nil {
+		return &pb.CheckLoginResponse{}, err
+	}
+	if bungee.Whitelist {
+		whitelisted, err := s.svc.MySQL.IsWhitelisted(e.PlayerUUID, e.PlayerName)
+		if err != nil {
+			return &pb.CheckLoginResponse{}, err
+		} else if !whitelisted {
+			return &pb.CheckLoginResponse{Allowed: false, Reason: "NOT_WHITELISTED"}, nil
+		}
+	}
+
+	return &pb.CheckLoginResponse{Allowed: true}, nil
+}
+
+// reloadIPBans - Apply IP ban changes to CheckLogin immediately
+func (s *grpcServer) reloadIPBans() {
+	if s.svc.IPBans == nil {
+		return
+	}
+	if err := s.svc.IPBans.Reload(); err != nil {
+		logrus.WithError(err).Errorf("[Punishment] Failed reload IP bans")
+	}
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) GetPlayerSessions(ctx context.Context, e *pb.GetPlayerSessionsRequest) (*pb.GetPlayerSessionsResponse, error) {
+	if len(e.PlayerUUID) == 0 {
+		return nil, errors.New("playerUUID is empty")
+	}
+
+	limit := int(e.Limit)
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 500 {
+		limit = 500
+	}
+
+	r, err := s.svc.MySQL.GetPlayerSessions(e.PlayerUUID, limit, int(e.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.PlayerSession
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.GetPlayerSessionsResponse{
+		Sessions: resp,
+	}, nil
+}
+
+func (s *grpcServer) GetPlayerPlaytime(ctx context.Context, e *pb.GetPlayerPlaytimeRequest) (*pb.GetPlayerPlaytimeResponse, error) {
+	if len(e.PlayerUUID) == 0 {
+		return nil, errors.New("playerUUID is empty")
+	}
+
+	since := time.Unix(e.Since, 0)
+	until := time.Now()
+	if e.Until != 0 {
+		until = time.Unix(e.Until, 0)
+	}
+
+	r, err := s.svc.MySQL.GetPlayerPlaytime(e.PlayerUUID, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetPlayerPlaytimeResponse{
+		Total:  r.Total,
+		Period: r.Period,
+		Online: r.Online,
+	}
+	if !r.FirstSeen.IsZero() {
+		resp.FirstSeen = r.FirstSeen.Unix()
+		resp.LastSeen = r.LastSeen.Unix()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+var errServerNotFound = errors.New("server not found")
+
+// lookupServer - Get server entry, errServerNotFound when not registered
+func (s *grpcServer) lookupServer(name string) (database.Servers, error) {
+	if len(name) == 0 {
+		return database.Servers{}, errors.New("server name is empty")
+	}
+
+	entry, err := s.svc.MySQL.GetServerEntry(name)
+	if err != nil {
+		return database.Servers{}, err
+	} else if entry.Name == "" {
+		return database.Servers{}, errServerNotFound
+	}
+
+	return entry, nil
+}
+
+func (s *grpcServer) TransferPlayer(ctx context.Context, e *pb.TransferPlayerRequest) (*pb.TransferPlayerResponse, error) {
+	if _, err := s.lookupServer(e.TargetServer); err != nil {
+		return &pb.TransferPlayerResponse{}, err
+	}
+
+	player, err := s.lookupPlayer(e.PlayerUUID, "", true)
+	if err != nil {
+		return &pb.TransferPlayerResponse{}, err
+	} else if player.CurrentServer == "" {
+		return &pb.TransferPlayerResponse{}, errPlayerNotOnline
+	} else if player.CurrentServer == e.TargetServer {
+		return &pb.TransferPlayerResponse{}, errors.New("player is already on the server")
+	}
+
+	if err := s.svc.MySQL.Enqueue(stream.PlayerTransferMessage(player.ProxyID, player.UUID, e.TargetServer)); err != nil {
+		return &pb.TransferPlayerResponse{}, err
+	}
+
+	return &pb.TransferPlayerResponse{Profile: player.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) TransferServer(ctx context.Context, e *pb.TransferServerRequest) (*pb.TransferServerResponse, error) {
+	if e.FromServer == e.ToServer {
+		return &pb.TransferServerResponse{}, errors.New("fromServer and toServer are same")
+	}
+	if _, err := s.lookupServer(e.ToServer); err != nil {
+		return &pb.TransferServerResponse{}, err
+	}
+
+	var uuids []string
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		players, _, err := tx.GetPlayersOnServer(e.FromServer, true, -1, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, player := range players {
+			if err := tx.Enqueue(stream.PlayerTransferMessage(player.ProxyID, player.UUID, e.ToServer)); err != nil {
+				return err
+			}
+			uuids = append(uuids, player.UUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return &pb.TransferServerResponse{}, err
+	}
+
+	return &pb.TransferServerResponse{PlayerUUIDs: uuids}, nil
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	pb "github.com/synchthia/nebula-api/nebulapb"
+	"github.com/synchthia/nebula-api/stream"
+	"golang.org/x/net/context"
+)
+
+func (s *grpcServer) SetWhitelist(ctx context.Context, e *pb.SetWhitelistRequest) (*pb.SetWhitelistResponse, error) {
+	var entry database.Bungee
+	err := s.svc.MySQL.Transaction(func(tx *database.Mysql) error {
+		if err := tx.SetWhitelist(e.Enabled); err != nil {
+			return err
+		}
+		var err error
+		entry, err = tx.GetBungeeEntry()
+		if err != nil {
+			return err
+		}
+		return tx.Enqueue(stream.BungeeEntryMessage(s.BungeeEntry_DBtoPB(entry), entry.Revision))
+	})
+	if err != nil {
+		return &pb.SetWhitelistResponse{}, err
+	}
+
+	logrus.Infof("[Whitelist] Whitelist: %t", e.Enabled)
+	return &pb.SetWhitelistResponse{Entry: s.BungeeEntry_DBtoPB(entry)}, nil
+}
+
+func (s *grpcServer) AddToWhitelist(ctx context.Context, e *pb.AddToWhitelistRequest) (*pb.AddToWhitelistResponse, error) {
+	if e.Entry == nil || (len(e.Entry.PlayerUUID) == 0 && len(e.Entry.PlayerName) == 0) {
+		return &pb.AddToWhitelistResponse{}, errors.New("playerUUID or playerName is required")
+	}
+
+	entry := &database.Whitelist{
+		UUID:    e.Entry.PlayerUUID,
+		Name:    e.Entry.PlayerName,
+		AddedBy: e.Entry.AddedBy,
+	}
+	if len(entry.UUID) != 0 && len(entry.Name) == 0 {
+		if player, err := s.svc.MySQL.GetPlayer(entry.UUID); err == nil {
+			entry.Name = player.Name
+		}
+	}
+	if err := s.svc.MySQL.AddWhitelist(entry); err != nil {
+		return &pb.AddToWhitelistResponse{}, err
+	}
+
+	return &pb.AddToWhitelistResponse{Entry: entry.ToProtobuf()}, nil
+}
+
+func (s *grpcServer) RemoveFromWhitelist(ctx context.Context, e *pb.RemoveFromWhitelistRequest) (*pb.RemoveFromWhitelistResponse, error) {
+	if len(e.PlayerUUID) == 0 && len(e.PlayerName) == 0 {
+		return &pb.RemoveFromWhitelistResponse{}, errors.New("playerUUID or playerName is required")
+	}
+
+	removed, err := s.svc.MySQL.RemoveWhitelist(e.PlayerUUID, e.PlayerName)
+	if err != nil {
+		return &pb.RemoveFromWhitelistResponse{}, err
+	} else if removed == 0 {
+		return &pb.RemoveFromWhitelistResponse{}, errors.New("player is not whitelisted")
+	}
+
+	return &pb.RemoveFromWhitelistResponse{}, nil
+}
+
+func (s *grpcServer) GetWhitelist(ctx context.Context, e *pb.GetWhitelistRequest) (*pb.GetWhitelistResponse, error) {
+	r, err := s.svc.MySQL.GetWhitelist()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*pb.WhitelistEntry
+	for _, r := range r {
+		resp = append(resp, r.ToProtobuf())
+	}
+
+	return &pb.GetWhitelistResponse{
+		Entries: resp,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+)
+
+// AddressHasher - Convert login address for storage (raw or HMAC-SHA256)
+type AddressHasher struct {
+	key []byte
+}
+
+// NewAddressHasher - Store raw address when key is empty
+func NewAddressHasher(key string) *AddressHasher {
+	return &AddressHasher{key: []byte(key)}
+}
+
+// Hashed - Addresses are stored as HMAC
+func (h *AddressHasher) Hashed() bool {
+	return h != nil && len(h.key) != 0
+}
+
+// Hash - Get stored form of address and its subnet (/24, /64)
+func (h *AddressHasher) Hash(ip string) (string, string, error) {
+	prefix, err := ParsePrefix(ip)
+	if err != nil {
+		return "", "", err
+	}
+
+	address := prefix.IP.String()
+	subnet := SubnetOf(prefix)
+	if !h.Hashed() {
+		return address, subnet, nil
+	}
+	return h.hmac(address), h.hmac(subnet), nil
+}
+
+// Match - Check stored address / subnet is in prefix.
+// Hashed address only matches single address or exact subnet (/24, /64) prefix.
+func (h *AddressHasher) Match(prefix *net.IPNet, address, subnet string) bool {
+	if !h.Hashed() {
+		ip := net.ParseIP(address)
+		return ip != nil && prefix.Contains(ip)
+	}
+
+	if ones, bits := prefix.Mask.Size(); ones == bits {
+		return address == h.hmac(prefix.IP.String())
+	} else if SubnetOf(prefix) == prefix.String() {
+		return subnet == h.hmac(prefix.String())
+	}
+	return false
+}
+
+func (h *AddressHasher) hmac(s string) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil))
+}
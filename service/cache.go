@@ -0,0 +1,197 @@
+package service
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// LookupCacheConfig - Lookup result cache config
+type LookupCacheConfig struct {
+	// TTL - lookup result lifetime (default: 24h)
+	TTL time.Duration
+	// NegativeTTL - failed lookup lifetime (default: 1m)
+	NegativeTTL time.Duration
+	// MaxSize - max entries in memory, least recently used entry is evicted (default: 100000)
+	MaxSize int
+	// Store - persistent cache shared with other instances (optional)
+	Store LookupStore
+}
+
+// LookupStore - Persistent lookup result store
+type LookupStore interface {
+	// Get - returns nil when not cached
+	Get(ip string) (*DBIPResult, error)
+	Set(ip string, result *DBIPResult, ttl time.Duration) error
+	Purge() error
+}
+
+// LookupCacheStats - Cache metrics
+type LookupCacheStats struct {
+	Hits         uint64
+	StoreHits    uint64
+	NegativeHits uint64
+	Misses       uint64
+	Shared       uint64
+	Evictions    uint64
+	Size         int
+}
+
+type lookupCache struct {
+	config *LookupCacheConfig
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru - most recently used first
+	lru   *list.List
+	group singleflight.Group
+
+	hits         uint64
+	storeHits    uint64
+	negativeHits uint64
+	misses       uint64
+	shared       uint64
+	evictions    uint64
+}
+
+type cacheEntry struct {
+	ip        string
+	result    *DBIPResult
+	err       error
+	expiresAt time.Time
+}
+
+func newLookupCache(config *LookupCacheConfig) *lookupCache {
+	if config == nil {
+		config = &LookupCacheConfig{}
+	}
+	if config.TTL <= 0 {
+		config.TTL = 24 * time.Hour
+	}
+	if config.NegativeTTL <= 0 {
+		config.NegativeTTL = time.Minute
+	}
+	if config.MaxSize <= 0 {
+		config.MaxSize = 100000
+	}
+
+	return &lookupCache{
+		config:  config,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// Get - Get cached result, or fetch it once for concurrent callers
+func (c *lookupCache) Get(ip string, fetch func(string) (*DBIPResult, error)) (*DBIPResult, error) {
+	entry, ok := c.get(ip)
+	if ok && time.Now().Before(entry.expiresAt) {
+		if entry.err != nil {
+			atomic.AddUint64(&c.negativeHits, 1)
+			return nil, entry.err
+		}
+		atomic.AddUint64(&c.hits, 1)
+		return entry.result, nil
+	}
+
+	r, err, shared := c.group.Do(ip, func() (interface{}, error) {
+		if c.config.Store != nil {
+			if r, err := c.config.Store.Get(ip); err == nil && r != nil {
+				atomic.AddUint64(&c.storeHits, 1)
+				c.set(ip, cacheEntry{result: r, expiresAt: time.Now().Add(c.config.TTL)})
+				return r, nil
+			}
+		}
+
+		atomic.AddUint64(&c.misses, 1)
+		r, err := fetch(ip)
+		if err != nil {
+			c.set(ip, cacheEntry{err: err, expiresAt: time.Now().Add(c.config.NegativeTTL)})
+			return nil, err
+		}
+
+		c.set(ip, cacheEntry{result: r, expiresAt: time.Now().Add(c.config.TTL)})
+		if c.config.Store != nil {
+			c.config.Store.Set(ip, r, c.config.TTL)
+		}
+		return r, nil
+	})
+	if shared {
+		atomic.AddUint64(&c.shared, 1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.(*DBIPResult), nil
+}
+
+func (c *lookupCache) get(ip string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[ip]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.lru.MoveToFront(e)
+	return *e.Value.(*cacheEntry), true
+}
+
+func (c *lookupCache) set(ip string, entry cacheEntry) {
+	entry.ip = ip
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[ip]; ok {
+		*e.Value.(*cacheEntry) = entry
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[ip] = c.lru.PushFront(&entry)
+
+	for c.lru.Len() > c.config.MaxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).ip)
+		atomic.AddUint64(&c.evictions, 1)
+	}
+}
+
+// Purge - Remove expired entries
+func (c *lookupCache) Purge() {
+	now := time.Now()
+	c.mu.Lock()
+	for ip, e := range c.entries {
+		if !now.Before(e.Value.(*cacheEntry).expiresAt) {
+			c.lru.Remove(e)
+			delete(c.entries, ip)
+		}
+	}
+	c.mu.Unlock()
+
+	if c.config.Store != nil {
+		c.config.Store.Purge()
+	}
+}
+
+// Stats - Get cache metrics
+func (c *lookupCache) Stats() LookupCacheStats {
+	c.mu.Lock()
+	size := len(c.entries)
+	c.mu.Unlock()
+
+	return LookupCacheStats{
+		Hits:         atomic.LoadUint64(&c.hits),
+		StoreHits:    atomic.LoadUint64(&c.storeHits),
+		NegativeHits: atomic.LoadUint64(&c.negativeHits),
+		Misses:       atomic.LoadUint64(&c.misses),
+		Shared:       atomic.LoadUint64(&c.shared),
+		Evictions:    atomic.LoadUint64(&c.evictions),
+		Size:         size,
+	}
+}
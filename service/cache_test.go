@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLookupCacheEviction(t *testing.T) {
+	cache := newLookupCache(&LookupCacheConfig{MaxSize: 2})
+	fetches := 0
+	fetch := func(ip string) (*DBIPResult, error) {
+		fetches++
+		return &DBIPResult{IPAddress: ip}, nil
+	}
+
+	cache.Get("192.0.2.1", fetch)
+	cache.Get("192.0.2.2", fetch)
+	// 192.0.2.1 becomes most recently used
+	cache.Get("192.0.2.1", fetch)
+	cache.Get("192.0.2.3", fetch)
+
+	stats := cache.Stats()
+	if stats.Size != 2 || stats.Evictions != 1 {
+		t.Fatalf("size = %d, evictions = %d, want 2, 1", stats.Size, stats.Evictions)
+	}
+
+	fetches = 0
+	if r, _ := cache.Get("192.0.2.1", fetch); r.IPAddress != "192.0.2.1" || fetches != 0 {
+		t.Errorf("recently used entry was evicted")
+	}
+	if cache.Get("192.0.2.2", fetch); fetches != 1 {
+		t.Errorf("least recently used entry was not evicted")
+	}
+}
+
+func TestLookupCacheNegative(t *testing.T) {
+	cache := newLookupCache(nil)
+	errLookup := errors.New("lookup failed")
+	fetches := 0
+	fetch := func(ip string) (*DBIPResult, error) {
+		fetches++
+		return nil, errLookup
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Get("192.0.2.1", fetch); err != errLookup {
+			t.Errorf("err = %v, want %v", err, errLookup)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("fetched %d times, want 1", fetches)
+	}
+	if stats := cache.Stats(); stats.NegativeHits != 1 || stats.Misses != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+)
+
+// IPBanList - Active IP bans in prefix tree (checked on every login)
+type IPBanList struct {
+	mysql *database.Mysql
+
+	mu    sync.RWMutex
+	rules *prefixTree
+}
+
+// NewIPBanList - Load active IP bans and reload periodically (bans added by other instances)
+func NewIPBanList(mysql *database.Mysql, interval time.Duration) (*IPBanList, error) {
+	bans := &IPBanList{
+		mysql: mysql,
+		rules: newPrefixTree(),
+	}
+	if err := bans.Reload(); err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	go func() {
+		for range time.Tick(interval) {
+			if err := bans.Reload(); err != nil {
+				logrus.WithError(err).Errorf("[Punishment] Failed reload IP bans")
+			}
+		}
+	}()
+
+	return bans, nil
+}
+
+// Reload - Rebuild prefix tree from active IP bans
+func (b *IPBanList) Reload() error {
+	entries, err := b.mysql.GetActiveIPBans()
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.rules = buildIPBanTree(entries)
+	b.mu.Unlock()
+
+	logrus.Debugf("[Punishment] Loaded %d IP bans", len(entries))
+	return nil
+}
+
+func buildIPBanTree(entries []database.Punishments) *prefixTree {
+	// same prefix may be banned more than once (newest first)
+	byPrefix := map[string][]*database.Punishments{}
+	prefixes := map[string]*net.IPNet{}
+	for i := range entries {
+		prefix, err := ParsePrefix(entries[i].Address)
+		if err != nil {
+			logrus.Warnf("[Punishment] Ignored invalid IP ban: %s", entries[i].Address)
+			continue
+		}
+		key := prefix.String()
+		byPrefix[key] = append(byPrefix[key], &entries[i])
+		prefixes[key] = prefix
+	}
+
+	rules := newPrefixTree()
+	for key, bans := range byPrefix {
+		rules.Insert(prefixes[key], bans)
+	}
+	return rules
+}
+
+// Match - Get active IP ban which contains ip (most specific first, nil when not banned)
+func (b *IPBanList) Match(ip string) *database.Punishments {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil
+	}
+
+	b.mu.RLock()
+	matches := b.rules.LookupAll(addr)
+	b.mu.RUnlock()
+
+	for i := len(matches) - 1; i >= 0; i-- {
+		for _, ban := range matches[i].([]*database.Punishments) {
+			if ban.IsActive() {
+				return ban
+			}
+		}
+	}
+	return nil
+}
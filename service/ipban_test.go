@@ -0,0 +1,75 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/nebula-api/database"
+)
+
+func TestIPBanListMatch(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	bans := &IPBanList{rules: buildIPBanTree([]database.Punishments{
+		{Id: 1, Address: "192.0.2.0/24"},
+		{Id: 2, Address: "192.0.2.0/28", ExpiresAt: &expired},
+		{Id: 3, Address: "192.0.2.100"},
+		{Id: 4, Address: "192.0.2.100", Revoked: true},
+		{Id: 5, Address: "2001:db8::/32"},
+		{Id: 6, Address: "invalid"},
+	})}
+
+	tests := []struct {
+		ip   string
+		want int64
+	}{
+		{ip: "192.0.2.1", want: 1},
+		{ip: "192.0.2.100", want: 3},
+		{ip: "::ffff:192.0.2.100", want: 3},
+		{ip: "198.51.100.1", want: 0},
+		{ip: "2001:db8::1", want: 5},
+		{ip: "invalid", want: 0},
+	}
+
+	for _, tt := range tests {
+		var got int64
+		if ban := bans.Match(tt.ip); ban != nil {
+			got = ban.Id
+		}
+		if got != tt.want {
+			t.Errorf("Match(%s) = %d, want %d", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestAddressHasherMatch(t *testing.T) {
+	raw := NewAddressHasher("")
+	hashed := NewAddressHasher("key")
+	hashedAddress, hashedSubnet, _ := hashed.Hash("192.0.2.1")
+
+	tests := []struct {
+		hasher  *AddressHasher
+		prefix  string
+		address string
+		subnet  string
+		want    bool
+	}{
+		{hasher: raw, prefix: "192.0.2.0/24", address: "192.0.2.1", want: true},
+		{hasher: raw, prefix: "192.0.0.0/16", address: "192.0.2.1", want: true},
+		{hasher: raw, prefix: "192.0.2.0/28", address: "192.0.2.100", want: false},
+		{hasher: hashed, prefix: "192.0.2.1", address: hashedAddress, subnet: hashedSubnet, want: true},
+		{hasher: hashed, prefix: "192.0.2.2", address: hashedAddress, subnet: hashedSubnet, want: false},
+		{hasher: hashed, prefix: "192.0.2.0/24", address: hashedAddress, subnet: hashedSubnet, want: true},
+		// hashed address can not be matched with other prefix length
+		{hasher: hashed, prefix: "192.0.0.0/16", address: hashedAddress, subnet: hashedSubnet, want: false},
+	}
+
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tt.hasher.Match(prefix, tt.address, tt.subnet); got != tt.want {
+			t.Errorf("Match(%s, hashed=%v) = %v, want %v", tt.prefix, tt.hasher.Hashed(), got, tt.want)
+		}
+	}
+}
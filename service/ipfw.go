@@ -1,21 +1,38 @@
 package service
 
 import (
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
+	"errors"
+	"net"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
 )
 
 type IPFilter struct {
+	mysql  *database.Mysql
 	config *IPFilterConfig
+
+	mu       sync.RWMutex
+	rules    *prefixTree
+	cache    *lookupCache
+	provider Provider
 }
 
 type IPFilterConfig struct {
-	// DBIPToken - db-ip.com private api token
+	// DBIPToken - db-ip.com private api token (used when Provider is nil)
 	DBIPToken string
+	// Provider - lookup provider
+	Provider Provider
+	// ReloadInterval - reload filter entries changed by other instances (default: 30s)
+	ReloadInterval time.Duration
+	// Policies - evaluated in order, first matched policy is applied (default: DefaultPolicies)
+	Policies []IPPolicy
+	// Cache - lookup result cache config
+	Cache *LookupCacheConfig
+	// FailClosed - treat address as suspicious when lookup failed (default: fail-open)
+	FailClosed bool
 }
 
 type DBIPResult struct {
@@ -53,37 +70,154 @@ type IPLookupResult struct {
 	ISP          string
 	IsSuspicious bool
 	Reason       string
+	// LookupFailed - result was decided by fail policy
+	LookupFailed bool
+	FailClosed   bool
+	Error        string
 }
 
-func NewIPFilter(config *IPFilterConfig) (*IPFilter, error) {
+func NewIPFilter(mysql *database.Mysql, config *IPFilterConfig) (*IPFilter, error) {
 	logrus.Printf("[IPFilter] Initialize IP Filter...")
 
-	return &IPFilter{
-		config: config,
-	}, nil
+	ipfw := &IPFilter{
+		mysql:    mysql,
+		config:   config,
+		rules:    newPrefixTree(),
+		cache:    newLookupCache(config.Cache),
+		provider: config.Provider,
+	}
+	if ipfw.provider == nil {
+		ipfw.provider = NewDBIPProvider(&DBIPConfig{Token: config.DBIPToken})
+	}
+	logrus.Printf("[IPFilter] Lookup provider: %s", ipfw.provider.Name())
+	if err := ipfw.Reload(); err != nil {
+		return nil, err
+	}
+
+	interval := config.ReloadInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	go func() {
+		for range time.Tick(interval) {
+			if err := ipfw.Reload(); err != nil {
+				logrus.WithError(err).Errorf("[IPFilter] Failed reload filter")
+			}
+			ipfw.cache.Purge()
+			if _, err := ipfw.mysql.PurgeIPFilter(time.Now()); err != nil {
+				logrus.WithError(err).Errorf("[IPFilter] Failed purge expired filter")
+			}
+		}
+	}()
+
+	return ipfw, nil
+}
+
+// Reload - Rebuild prefix tree from filter entries
+func (ipfw *IPFilter) Reload() error {
+	entries, err := ipfw.mysql.ListIPFilter()
+	if err != nil {
+		return err
+	}
+
+	rules := newPrefixTree()
+	for i := range entries {
+		prefix, err := ParsePrefix(entries[i].Address)
+		if err != nil {
+			logrus.Warnf("[IPFilter] Ignored invalid filter: %s", entries[i].Address)
+			continue
+		}
+		rules.Insert(prefix, &entries[i])
+	}
+
+	ipfw.mu.Lock()
+	ipfw.rules = rules
+	ipfw.mu.Unlock()
+
+	logrus.Debugf("[IPFilter] Loaded %d filters", len(entries))
+	return nil
+}
+
+// Match - Get the most specific active filter entry which contains ip (nil when not matched)
+func (ipfw *IPFilter) Match(ip string) *database.IPFilter {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil
+	}
+
+	ipfw.mu.RLock()
+	matches := ipfw.rules.LookupAll(addr)
+	ipfw.mu.RUnlock()
+
+	// expired entry falls back to covering rule
+	now := time.Now()
+	for i := len(matches) - 1; i >= 0; i-- {
+		entry := matches[i].(*database.IPFilter)
+		if entry.ExpiresAt == nil || entry.ExpiresAt.After(now) {
+			return entry
+		}
+	}
+	return nil
 }
 
 // Check - check user ip
 func (ipfw *IPFilter) Check(ip string) (*IPLookupResult, error) {
-	// Check DBIP
-	dbipRes, err := ipfw.LookupDBIP(ip)
-	if err != nil {
-		return nil, err
+	// Check filter entries (before external lookup)
+	if entry := ipfw.Match(ip); entry != nil {
+		switch entry.Action {
+		case database.ALLOW:
+			return &IPLookupResult{
+				IPAddress:    ip,
+				IsSuspicious: false,
+				Reason:       "FILTER_ALLOW",
+			}, nil
+		case database.DENY:
+			return &IPLookupResult{
+				IPAddress:    ip,
+				IsSuspicious: true,
+				Reason:       "FILTER_DENY",
+			}, nil
+		}
 	}
 
-	if dbipRes.ThreatLevel == "medium" || dbipRes.ThreatLevel == "high" {
+	// Check DBIP
+	dbipRes, err := ipfw.cache.Get(ip, ipfw.provider.Lookup)
+	if errors.Is(err, ErrNoLookupResult) {
+		// no data (e.g. private address): not a failure, policies are skipped
 		return &IPLookupResult{
-			IPAddress:    dbipRes.IPAddress,
-			ISP:          dbipRes.Isp,
-			IsSuspicious: true,
-			Reason:       "THREAT_LEVEL_ABOVE_MEDIUM",
+			IPAddress:    ip,
+			IsSuspicious: false,
+			Reason:       "NO_LOOKUP_RESULT",
 		}, nil
-	} else if dbipRes.UsageType == "hosting" {
+	} else if err != nil {
+		logrus.WithError(err).Warnf("[IPFilter] Lookup failed: %s", ip)
+		reason := "LOOKUP_FAILED_OPEN"
+		if ipfw.config.FailClosed {
+			reason = "LOOKUP_FAILED_CLOSED"
+		}
+		return &IPLookupResult{
+			IPAddress:    ip,
+			IsSuspicious: ipfw.config.FailClosed,
+			Reason:       reason,
+			LookupFailed: true,
+			FailClosed:   ipfw.config.FailClosed,
+			Error:        err.Error(),
+		}, nil
+	}
+
+	policies := ipfw.config.Policies
+	if policies == nil {
+		policies = DefaultPolicies
+	}
+	for _, p := range policies {
+		if !p.Match(dbipRes) {
+			continue
+		}
 		return &IPLookupResult{
 			IPAddress:    dbipRes.IPAddress,
 			ISP:          dbipRes.Isp,
-			IsSuspicious: true,
-			Reason:       "HOSTING",
+			IsSuspicious: p.Action == PolicyDeny,
+			Reason:       p.Name,
 		}, nil
 	}
 
@@ -93,38 +227,7 @@ func (ipfw *IPFilter) Check(ip string) (*IPLookupResult, error) {
 	}, nil
 }
 
-// LookupDBIP - check user ip
-func (ipfw *IPFilter) LookupDBIP(ip string) (*DBIPResult, error) {
-	if len(ipfw.config.DBIPToken) == 0 {
-		logrus.Printf("ERR: DB_IP_TOKEN is empty?")
-	}
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("https://api.db-ip.com/v2/%s/%s", ipfw.config.DBIPToken, ip),
-		nil,
-	)
-	req.Header.Set("Content-Type", "application/json")
-
-	if err != nil {
-		return nil, err
-	}
-
-	client := http.DefaultClient
-	res, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	// Parse result
-	b, _ := ioutil.ReadAll(res.Body)
-	var parsed *DBIPResult
-
-	if err := json.Unmarshal(b, &parsed); err != nil {
-		return nil, err
-	}
-
-	//:wlogrus.Debugf("[IPFilter] Lookup result")
-
-	return parsed, nil
+// CacheStats - Get lookup cache metrics
+func (ipfw *IPFilter) CacheStats() LookupCacheStats {
+	return ipfw.cache.Stats()
 }
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/nebula-api/database"
+)
+
+func TestIPFilterMatch(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	later := time.Now().Add(time.Hour)
+
+	rules := newPrefixTree()
+	for _, entry := range []*database.IPFilter{
+		{Id: 1, Action: database.ALLOW, Address: "192.0.0.0/16"},
+		{Id: 2, Action: database.DENY, Address: "192.0.2.0/24"},
+		{Id: 3, Action: database.DENY, Address: "192.0.2.1", ExpiresAt: &expired},
+		{Id: 4, Action: database.DENY, Address: "192.0.3.1", ExpiresAt: &expired},
+		{Id: 5, Action: database.DENY, Address: "192.0.4.1", ExpiresAt: &later},
+	} {
+		prefix, err := ParsePrefix(entry.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules.Insert(prefix, entry)
+	}
+	ipfw := &IPFilter{rules: rules}
+
+	tests := []struct {
+		ip   string
+		want int64
+	}{
+		{ip: "192.0.2.1", want: 2},
+		{ip: "192.0.3.1", want: 1},
+		{ip: "192.0.4.1", want: 5},
+		{ip: "192.0.9.9", want: 1},
+		{ip: "198.51.100.1", want: 0},
+	}
+
+	for _, tt := range tests {
+		var got int64
+		if entry := ipfw.Match(tt.ip); entry != nil {
+			got = entry.Id
+		}
+		if got != tt.want {
+			t.Errorf("Match(%s) = %d, want %d", tt.ip, got, tt.want)
+		}
+	}
+}
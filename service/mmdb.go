@@ -0,0 +1,128 @@
+package service
+
+import (
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// MMDBProvider - Local MaxMind / DB-IP .mmdb databases (City / Country / ASN / ISP)
+type MMDBProvider struct {
+	readers []*maxminddb.Reader
+}
+
+type mmdbRecord struct {
+	Continent struct {
+		Code  string            `maxminddb:"code"`
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"continent"`
+	Country struct {
+		IsoCode           string            `maxminddb:"iso_code"`
+		Names             map[string]string `maxminddb:"names"`
+		IsInEuropeanUnion bool              `maxminddb:"is_in_european_union"`
+	} `maxminddb:"country"`
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+		TimeZone  string  `maxminddb:"time_zone"`
+	} `maxminddb:"location"`
+	Traits struct {
+		AutonomousSystemNumber       int    `maxminddb:"autonomous_system_number"`
+		AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+		ISP                          string `maxminddb:"isp"`
+		UserType                     string `maxminddb:"user_type"`
+		IsAnonymousProxy             bool   `maxminddb:"is_anonymous_proxy"`
+	} `maxminddb:"traits"`
+
+	// ASN / ISP databases
+	AutonomousSystemNumber       int    `maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string `maxminddb:"autonomous_system_organization"`
+	ISP                          string `maxminddb:"isp"`
+	UserType                     string `maxminddb:"user_type"`
+}
+
+// NewMMDBProvider - Open databases, results are merged in order
+func NewMMDBProvider(paths ...string) (*MMDBProvider, error) {
+	p := &MMDBProvider{}
+	for _, path := range paths {
+		r, err := maxminddb.Open(path)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.readers = append(p.readers, r)
+	}
+	return p, nil
+}
+
+func (p *MMDBProvider) Name() string {
+	return "mmdb"
+}
+
+func (p *MMDBProvider) Close() {
+	for _, r := range p.readers {
+		r.Close()
+	}
+}
+
+func (p *MMDBProvider) Lookup(ip string) (*DBIPResult, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, ErrNoLookupResult
+	}
+
+	result := &DBIPResult{IPAddress: ip}
+	found := false
+	for _, r := range p.readers {
+		var record mmdbRecord
+		_, ok, err := r.LookupNetwork(addr, &record)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		found = true
+		mergeMMDBRecord(result, &record)
+	}
+	if !found {
+		return nil, ErrNoLookupResult
+	}
+
+	return result, nil
+}
+
+func mergeMMDBRecord(result *DBIPResult, record *mmdbRecord) {
+	setString := func(dst *string, values ...string) {
+		for _, v := range values {
+			if len(*dst) == 0 && len(v) != 0 {
+				*dst = v
+			}
+		}
+	}
+
+	setString(&result.ContinentCode, record.Continent.Code)
+	setString(&result.ContinentName, record.Continent.Names["en"])
+	setString(&result.CountryCode, record.Country.IsoCode)
+	setString(&result.CountryName, record.Country.Names["en"])
+	setString(&result.City, record.City.Names["en"])
+	setString(&result.TimeZone, record.Location.TimeZone)
+	setString(&result.AsName, record.AutonomousSystemOrganization, record.Traits.AutonomousSystemOrganization)
+	setString(&result.Isp, record.ISP, record.Traits.ISP, record.AutonomousSystemOrganization, record.Traits.AutonomousSystemOrganization)
+	setString(&result.UsageType, record.UserType, record.Traits.UserType)
+
+	if result.AsNumber == 0 {
+		result.AsNumber = record.AutonomousSystemNumber
+	}
+	if result.AsNumber == 0 {
+		result.AsNumber = record.Traits.AutonomousSystemNumber
+	}
+	if result.Latitude == 0 && result.Longitude == 0 {
+		result.Latitude = float32(record.Location.Latitude)
+		result.Longitude = float32(record.Location.Longitude)
+	}
+	result.IsEuMember = result.IsEuMember || record.Country.IsInEuropeanUnion
+	result.IsProxy = result.IsProxy || record.Traits.IsAnonymousProxy
+}
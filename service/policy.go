@@ -0,0 +1,131 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+)
+
+// IPPolicy - Rule for external lookup result.
+// Every specified condition must match (any of listed values).
+type IPPolicy struct {
+	// Name - returned as IPLookupResult.Reason (default: <ACTION>_<CONDITION>)
+	Name         string   `json:"name"`
+	Action       string   `json:"action"`
+	ASNs         []int    `json:"asn"`
+	Countries    []string `json:"country"`
+	Continents   []string `json:"continent"`
+	UsageTypes   []string `json:"usageType"`
+	ThreatLevels []string `json:"threatLevel"`
+	Proxy        *bool    `json:"proxy"`
+}
+
+const (
+	PolicyAllow = "allow"
+	PolicyDeny  = "deny"
+)
+
+// DefaultPolicies - Deny threat level above medium and hosting
+var DefaultPolicies = []IPPolicy{
+	{Name: "THREAT_LEVEL_ABOVE_MEDIUM", Action: PolicyDeny, ThreatLevels: []string{"medium", "high"}},
+	{Name: "HOSTING", Action: PolicyDeny, UsageTypes: []string{"hosting"}},
+}
+
+// ParsePolicies - Parse policies from json array
+func ParsePolicies(b []byte) ([]IPPolicy, error) {
+	var policies []IPPolicy
+	if err := json.Unmarshal(b, &policies); err != nil {
+		return nil, err
+	}
+
+	for i := range policies {
+		p := &policies[i]
+		p.Action = strings.ToLower(p.Action)
+		if p.Action != PolicyAllow && p.Action != PolicyDeny {
+			return nil, errors.New("unknown policy action: " + p.Action)
+		}
+		if len(p.Name) == 0 {
+			p.Name = p.defaultName()
+		}
+	}
+
+	return policies, nil
+}
+
+// LoadPolicies - Load policies from json file
+func LoadPolicies(path string) ([]IPPolicy, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicies(b)
+}
+
+func (p *IPPolicy) defaultName() string {
+	var conditions []string
+	if len(p.ASNs) != 0 {
+		conditions = append(conditions, "ASN")
+	}
+	if len(p.Countries) != 0 {
+		conditions = append(conditions, "COUNTRY")
+	}
+	if len(p.Continents) != 0 {
+		conditions = append(conditions, "CONTINENT")
+	}
+	if len(p.UsageTypes) != 0 {
+		conditions = append(conditions, "USAGE_TYPE")
+	}
+	if len(p.ThreatLevels) != 0 {
+		conditions = append(conditions, "THREAT_LEVEL")
+	}
+	if p.Proxy != nil {
+		conditions = append(conditions, "PROXY")
+	}
+	if len(conditions) == 0 {
+		conditions = append(conditions, "ALL")
+	}
+
+	return strings.ToUpper(p.Action) + "_" + strings.Join(conditions, "_")
+}
+
+// Match - Check lookup result matches all conditions
+func (p *IPPolicy) Match(r *DBIPResult) bool {
+	if len(p.ASNs) != 0 && !containsInt(p.ASNs, r.AsNumber) {
+		return false
+	}
+	if len(p.Countries) != 0 && !containsFold(p.Countries, r.CountryCode) {
+		return false
+	}
+	if len(p.Continents) != 0 && !containsFold(p.Continents, r.ContinentCode) {
+		return false
+	}
+	if len(p.UsageTypes) != 0 && !containsFold(p.UsageTypes, r.UsageType) {
+		return false
+	}
+	if len(p.ThreatLevels) != 0 && !containsFold(p.ThreatLevels, r.ThreatLevel) {
+		return false
+	}
+	if p.Proxy != nil && *p.Proxy != r.IsProxy {
+		return false
+	}
+	return true
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, v string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package service
+
+import "testing"
+
+func TestIPPolicyMatch(t *testing.T) {
+	yes := true
+	no := false
+	result := &DBIPResult{
+		AsNumber:      64500,
+		CountryCode:   "JP",
+		ContinentCode: "AS",
+		UsageType:     "hosting",
+		ThreatLevel:   "low",
+		IsProxy:       true,
+	}
+
+	tests := []struct {
+		name   string
+		policy IPPolicy
+		want   bool
+	}{
+		{name: "no condition", policy: IPPolicy{}, want: true},
+		{name: "asn", policy: IPPolicy{ASNs: []int{64499, 64500}}, want: true},
+		{name: "asn mismatch", policy: IPPolicy{ASNs: []int{64499}}, want: false},
+		{name: "country ignores case", policy: IPPolicy{Countries: []string{"jp"}}, want: true},
+		{name: "continent mismatch", policy: IPPolicy{Continents: []string{"EU"}}, want: false},
+		{name: "usage type", policy: IPPolicy{UsageTypes: []string{"HOSTING"}}, want: true},
+		{name: "threat level mismatch", policy: IPPolicy{ThreatLevels: []string{"medium", "high"}}, want: false},
+		{name: "proxy", policy: IPPolicy{Proxy: &yes}, want: true},
+		{name: "not proxy", policy: IPPolicy{Proxy: &no}, want: false},
+		{name: "all conditions", policy: IPPolicy{Countries: []string{"JP"}, UsageTypes: []string{"hosting"}, Proxy: &yes}, want: true},
+		{name: "one condition mismatch", policy: IPPolicy{Countries: []string{"JP"}, UsageTypes: []string{"isp"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.Match(result); got != tt.want {
+			t.Errorf("%s: Match = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIPPolicyDefaultName(t *testing.T) {
+	yes := true
+	tests := []struct {
+		policy IPPolicy
+		want   string
+	}{
+		{policy: IPPolicy{Action: PolicyDeny}, want: "DENY_ALL"},
+		{policy: IPPolicy{Action: PolicyAllow, ASNs: []int{64500}}, want: "ALLOW_ASN"},
+		{policy: IPPolicy{Action: PolicyDeny, Countries: []string{"JP"}, UsageTypes: []string{"hosting"}}, want: "DENY_COUNTRY_USAGE_TYPE"},
+		{policy: IPPolicy{Action: PolicyDeny, Continents: []string{"EU"}, ThreatLevels: []string{"high"}, Proxy: &yes}, want: "DENY_CONTINENT_THREAT_LEVEL_PROXY"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.defaultName(); got != tt.want {
+			t.Errorf("defaultName = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies([]byte(`[
+		{"action": "ALLOW", "asn": [64500]},
+		{"name": "BLOCK_VPN", "action": "deny", "proxy": true}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(policies) != 2 {
+		t.Fatalf("parsed %d policies, want 2", len(policies))
+	}
+	if policies[0].Action != PolicyAllow || policies[0].Name != "ALLOW_ASN" {
+		t.Errorf("unexpected policy: %+v", policies[0])
+	}
+	if policies[1].Name != "BLOCK_VPN" || policies[1].Proxy == nil || !*policies[1].Proxy {
+		t.Errorf("unexpected policy: %+v", policies[1])
+	}
+
+	if _, err := ParsePolicies([]byte(`[{"action": "drop"}]`)); err == nil {
+		t.Error("parsed unknown action")
+	}
+	if _, err := ParsePolicies([]byte(`{}`)); err == nil {
+		t.Error("parsed non-array")
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// prefixTree - Binary radix tree for longest prefix match (IPv4 / IPv6)
+type prefixTree struct {
+	v4 *prefixNode
+	v6 *prefixNode
+}
+
+type prefixNode struct {
+	children [2]*prefixNode
+	value    interface{}
+}
+
+func newPrefixTree() *prefixTree {
+	return &prefixTree{
+		v4: &prefixNode{},
+		v6: &prefixNode{},
+	}
+}
+
+// ParsePrefix - Parse IP address or CIDR, single address is treated as /32 (/128)
+func ParsePrefix(address string) (*net.IPNet, error) {
+	if strings.Contains(address, "/") {
+		_, ipNet, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, err
+		}
+		if ip4 := ipNet.IP.To4(); ip4 != nil {
+			ones, _ := ipNet.Mask.Size()
+			if len(ipNet.Mask) == net.IPv6len {
+				ones -= 96
+			}
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(ones, 32)}, nil
+		}
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, errors.New("invalid address")
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+func (t *prefixTree) root(ip net.IP) (*prefixNode, net.IP) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return t.v4, ip4
+	}
+	return t.v6, ip.To16()
+}
+
+// Insert - Set value for prefix (overwrite when exists)
+func (t *prefixTree) Insert(prefix *net.IPNet, value interface{}) {
+	node, ip := t.root(prefix.IP)
+	ones, _ := prefix.Mask.Size()
+	for i := 0; i < ones; i++ {
+		bit := ip[i/8] >> (7 - uint(i%8)) & 1
+		if node.children[bit] == nil {
+			node.children[bit] = &prefixNode{}
+		}
+		node = node.children[bit]
+	}
+	node.value = value
+}
+
+// Lookup - Get value of the most specific prefix which contains ip
+func (t *prefixTree) Lookup(ip net.IP) interface{} {
+	node, ip := t.root(ip)
+	if ip == nil {
+		return nil
+	}
+
+	value := node.value
+	for i := 0; i < len(ip)*8; i++ {
+		node = node.children[ip[i/8]>>(7-uint(i%8))&1]
+		if node == nil {
+			break
+		}
+		if node.value != nil {
+			value = node.value
+		}
+	}
+	return value
+}
+
+// LookupAll - Get values of every prefix which contains ip (least specific first)
+func (t *prefixTree) LookupAll(ip net.IP) []interface{} {
+	node, ip := t.root(ip)
+	if ip == nil {
+		return nil
+	}
+
+	var values []interface{}
+	if node.value != nil {
+		values = append(values, node.value)
+	}
+	for i := 0; i < len(ip)*8; i++ {
+		node = node.children[ip[i/8]>>(7-uint(i%8))&1]
+		if node == nil {
+			break
+		}
+		if node.value != nil {
+			values = append(values, node.value)
+		}
+	}
+	return values
+}
+
+// SubnetOf - /24 for IPv4, /64 for IPv6
+func SubnetOf(prefix *net.IPNet) string {
+	bits := 64
+	if prefix.IP.To4() != nil {
+		bits = 24
+	}
+	return (&net.IPNet{IP: prefix.IP.Mask(net.CIDRMask(bits, len(prefix.IP)*8)), Mask: net.CIDRMask(bits, len(prefix.IP)*8)}).String()
+}
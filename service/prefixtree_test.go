@@ -0,0 +1,122 @@
+package service
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+		wantErr bool
+	}{
+		{address: "192.0.2.1", want: "192.0.2.1/32"},
+		{address: "192.0.2.1/24", want: "192.0.2.0/24"},
+		{address: "0.0.0.0/0", want: "0.0.0.0/0"},
+		{address: "2001:db8::1", want: "2001:db8::1/128"},
+		{address: "2001:db8::1/48", want: "2001:db8::/48"},
+		{address: "::ffff:192.0.2.1", want: "192.0.2.1/32"},
+		{address: "::ffff:192.0.2.0/120", want: "192.0.2.0/24"},
+		{address: "::ffff:10.1.2.3/104", want: "10.0.0.0/8"},
+		{address: "::ffff:0:0/96", want: "0.0.0.0/0"},
+		{address: "::ffff:10.0.0.0/90", want: "::ffc0:0:0/90"},
+		{address: "", wantErr: true},
+		{address: "example.com", wantErr: true},
+		{address: "192.0.2.1/33", wantErr: true},
+		{address: "192.0.2.256", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePrefix(tt.address)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParsePrefix(%q) = %s, want error", tt.address, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParsePrefix(%q) error: %s", tt.address, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParsePrefix(%q) = %s, want %s", tt.address, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixTreeLookup(t *testing.T) {
+	tree := newPrefixTree()
+	for _, address := range []string{
+		"10.0.0.0/8",
+		"10.1.0.0/16",
+		"10.1.2.3",
+		"2001:db8::/32",
+		"2001:db8:1::/48",
+	} {
+		prefix, err := ParsePrefix(address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree.Insert(prefix, address)
+	}
+
+	tests := []struct {
+		ip   string
+		want interface{}
+	}{
+		{ip: "10.9.9.9", want: "10.0.0.0/8"},
+		{ip: "10.1.9.9", want: "10.1.0.0/16"},
+		{ip: "10.1.2.3", want: "10.1.2.3"},
+		{ip: "10.1.2.4", want: "10.1.0.0/16"},
+		{ip: "::ffff:10.1.2.3", want: "10.1.2.3"},
+		{ip: "11.0.0.1", want: nil},
+		{ip: "2001:db8:2::1", want: "2001:db8::/32"},
+		{ip: "2001:db8:1::1", want: "2001:db8:1::/48"},
+		{ip: "2001:db9::1", want: nil},
+		// IPv4 prefix never matches IPv6 address
+		{ip: "::a01:203", want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := tree.Lookup(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("Lookup(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixTreeOverwrite(t *testing.T) {
+	tree := newPrefixTree()
+	prefix, _ := ParsePrefix("0.0.0.0/0")
+	tree.Insert(prefix, "first")
+	tree.Insert(prefix, "second")
+
+	if got := tree.Lookup(net.ParseIP("192.0.2.1")); got != "second" {
+		t.Errorf("Lookup = %v, want second", got)
+	}
+	if got := tree.Lookup(net.ParseIP("2001:db8::1")); got != nil {
+		t.Errorf("Lookup(IPv6) = %v, want nil", got)
+	}
+}
+
+func TestSubnetOf(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{address: "192.0.2.123", want: "192.0.2.0/24"},
+		{address: "::ffff:192.0.2.123", want: "192.0.2.0/24"},
+		{address: "192.0.0.0/16", want: "192.0.0.0/24"},
+		{address: "2001:db8:1:2:3:4:5:6", want: "2001:db8:1:2::/64"},
+	}
+
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := SubnetOf(prefix); got != tt.want {
+			t.Errorf("SubnetOf(%s) = %s, want %s", tt.address, got, tt.want)
+		}
+	}
+}
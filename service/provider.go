@@ -0,0 +1,236 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNoLookupResult - Provider has no information about the address
+var ErrNoLookupResult = errors.New("no lookup result")
+
+// Provider - IP information source
+type Provider interface {
+	Name() string
+	Lookup(ip string) (*DBIPResult, error)
+}
+
+// DBIPProvider - db-ip.com REST API
+type DBIPProvider struct {
+	config *DBIPConfig
+	client *http.Client
+}
+
+// DBIPConfig - db-ip.com API config
+type DBIPConfig struct {
+	// Token - db-ip.com private api token
+	Token string
+	// Timeout - per request timeout (default: 3s)
+	Timeout time.Duration
+	// Retries - retry count on network error / 429 / 5xx (default: 2, negative: no retry)
+	Retries int
+	// Backoff - first retry delay, doubled on each retry (default: 200ms)
+	Backoff time.Duration
+}
+
+// dbipError - error response
+type dbipError struct {
+	Error     string `json:"error"`
+	ErrorCode string `json:"errorCode"`
+}
+
+func NewDBIPProvider(config *DBIPConfig) *DBIPProvider {
+	if config.Timeout <= 0 {
+		config.Timeout = 3 * time.Second
+	}
+	if config.Retries < 0 {
+		config.Retries = 0
+	} else if config.Retries == 0 {
+		config.Retries = 2
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = 200 * time.Millisecond
+	}
+
+	return &DBIPProvider{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (p *DBIPProvider) Name() string {
+	return "dbip"
+}
+
+// Lookup - check user ip (retry on temporary failure)
+func (p *DBIPProvider) Lookup(ip string) (*DBIPResult, error) {
+	if len(p.config.Token) == 0 {
+		logrus.Printf("ERR: DB_IP_TOKEN is empty?")
+	}
+
+	backoff := p.config.Backoff
+	for attempt := 0; ; attempt++ {
+		r, retry, err := p.lookup(ip)
+		if err == nil {
+			return r, nil
+		} else if !retry || attempt >= p.config.Retries {
+			return nil, err
+		}
+
+		logrus.WithError(err).Debugf("[IPFilter] Retrying db-ip lookup in %s: %s", backoff, ip)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// lookup - single request, returns whether the error is temporary
+func (p *DBIPProvider) lookup(ip string) (*DBIPResult, bool, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("https://api.db-ip.com/v2/%s/%s", p.config.Token, ip),
+		nil,
+	)
+	if err != nil {
+		return nil, false, p.redact(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, true, p.redact(err)
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, true, err
+	}
+
+	// db-ip returns error as json body
+	var apiErr dbipError
+	json.Unmarshal(b, &apiErr)
+	if res.StatusCode != http.StatusOK || len(apiErr.Error) != 0 {
+		retry := res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+		if len(apiErr.Error) == 0 {
+			apiErr.Error = http.StatusText(res.StatusCode)
+		}
+		return nil, retry, fmt.Errorf("db-ip: %s (status %d)", apiErr.Error, res.StatusCode)
+	}
+
+	// Parse result
+	var parsed *DBIPResult
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		return nil, false, err
+	}
+	if parsed == nil || len(parsed.IPAddress) == 0 {
+		return nil, false, errors.New("invalid lookup result")
+	}
+
+	return parsed, false, nil
+}
+
+// redact - Remove token from request URL in error (returned to IPLookup callers)
+func (p *DBIPProvider) redact(err error) error {
+	var urlErr *url.Error
+	if len(p.config.Token) != 0 && errors.As(err, &urlErr) {
+		urlErr.URL = strings.ReplaceAll(urlErr.URL, p.config.Token, "<token>")
+	}
+	return err
+}
+
+// StaticProvider - Fixed results by address / CIDR (for testing or offline use)
+type StaticProvider struct {
+	results *prefixTree
+	count   int
+}
+
+// NewStaticProvider - Create provider from {"<ip or cidr>": result} map
+func NewStaticProvider(results map[string]*DBIPResult) (*StaticProvider, error) {
+	p := &StaticProvider{results: newPrefixTree()}
+	for address, r := range results {
+		prefix, err := ParsePrefix(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %v", address, err)
+		}
+		p.results.Insert(prefix, r)
+		p.count++
+	}
+	return p, nil
+}
+
+// LoadStaticProvider - Load static results from json file
+func LoadStaticProvider(path string) (*StaticProvider, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var results map[string]*DBIPResult
+	if err := json.Unmarshal(b, &results); err != nil {
+		return nil, err
+	}
+	return NewStaticProvider(results)
+}
+
+func (p *StaticProvider) Name() string {
+	return "static"
+}
+
+func (p *StaticProvider) Lookup(ip string) (*DBIPResult, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, errors.New("invalid address")
+	}
+
+	r, ok := p.results.Lookup(addr).(*DBIPResult)
+	if !ok || r == nil {
+		return nil, ErrNoLookupResult
+	}
+
+	result := *r
+	result.IPAddress = ip
+	return &result, nil
+}
+
+// ChainProvider - Try providers in order until one succeeds
+type ChainProvider struct {
+	providers []Provider
+}
+
+func NewChainProvider(providers ...Provider) *ChainProvider {
+	return &ChainProvider{providers: providers}
+}
+
+func (p *ChainProvider) Name() string {
+	var names []string
+	for _, provider := range p.providers {
+		names = append(names, provider.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (p *ChainProvider) Lookup(ip string) (*DBIPResult, error) {
+	// ErrNoLookupResult only when no provider failed
+	lookupErr := ErrNoLookupResult
+	for _, provider := range p.providers {
+		r, err := provider.Lookup(ip)
+		if err == nil {
+			return r, nil
+		}
+		if !errors.Is(err, ErrNoLookupResult) {
+			logrus.WithError(err).Warnf("[IPFilter] Lookup failed with %s: %s", provider.Name(), ip)
+			if errors.Is(lookupErr, ErrNoLookupResult) {
+				lookupErr = err
+			}
+		}
+	}
+	return nil, lookupErr
+}
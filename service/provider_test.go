@@ -0,0 +1,87 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestDBIPProviderRedactToken(t *testing.T) {
+	p := NewDBIPProvider(&DBIPConfig{Token: "secret-token", Retries: -1})
+	p.client.Transport = roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	_, err := p.Lookup("192.0.2.1")
+	if err == nil {
+		t.Fatal("lookup succeeded")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("token leaked in error: %s", err)
+	}
+}
+
+type failProvider struct{}
+
+func (failProvider) Name() string { return "fail" }
+
+func (failProvider) Lookup(ip string) (*DBIPResult, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestChainProvider(t *testing.T) {
+	static, err := NewStaticProvider(map[string]*DBIPResult{
+		"192.0.2.0/24": {IPAddress: "192.0.2.0", Isp: "example"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r, err := NewChainProvider(failProvider{}, static).Lookup("192.0.2.1"); err != nil || r.Isp != "example" {
+		t.Errorf("Lookup = %v, %v", r, err)
+	}
+	if _, err := NewChainProvider(static, static).Lookup("198.51.100.1"); !errors.Is(err, ErrNoLookupResult) {
+		t.Errorf("no data: err = %v, want ErrNoLookupResult", err)
+	}
+	// failure is not hidden by provider without data
+	if _, err := NewChainProvider(failProvider{}, static).Lookup("198.51.100.1"); err == nil || errors.Is(err, ErrNoLookupResult) {
+		t.Errorf("failure: err = %v, want lookup error", err)
+	}
+}
+
+func TestIPFilterCheckNoLookupResult(t *testing.T) {
+	static, err := NewStaticProvider(map[string]*DBIPResult{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ipfw := &IPFilter{
+		config:   &IPFilterConfig{FailClosed: true},
+		rules:    newPrefixTree(),
+		cache:    newLookupCache(nil),
+		provider: static,
+	}
+
+	r, err := ipfw.Check("10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.IsSuspicious || r.LookupFailed {
+		t.Errorf("no data treated as failure: %+v", r)
+	}
+
+	ipfw.provider = failProvider{}
+	r, err = ipfw.Check("10.0.0.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.IsSuspicious || !r.LookupFailed || r.Reason != "LOOKUP_FAILED_CLOSED" {
+		t.Errorf("failure not closed: %+v", r)
+	}
+}
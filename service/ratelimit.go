@@ -0,0 +1,224 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// RateCounter - Sliding window counter
+type RateCounter interface {
+	// Hit - Record event and return events in window (including this one)
+	Hit(key string, window time.Duration) (int64, error)
+}
+
+// MemoryRateCounter - Count in process memory (not shared with other instances)
+type MemoryRateCounter struct {
+	mu   sync.Mutex
+	keys map[string]*rateEvents
+}
+
+type rateEvents struct {
+	window time.Duration
+	events []time.Time
+}
+
+// memoryRateSweepInterval - idle keys are removed in background
+const memoryRateSweepInterval = time.Minute
+
+func NewMemoryRateCounter() *MemoryRateCounter {
+	m := &MemoryRateCounter{
+		keys: map[string]*rateEvents{},
+	}
+	go func() {
+		for range time.Tick(memoryRateSweepInterval) {
+			m.Sweep()
+		}
+	}()
+	return m
+}
+
+func (m *MemoryRateCounter) Hit(key string, window time.Duration) (int64, error) {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.keys[key]
+	if !ok {
+		e = &rateEvents{}
+		m.keys[key] = e
+	}
+	e.window = window
+	e.events = append(trimEvents(e.events, now.Add(-window)), now)
+
+	return int64(len(e.events)), nil
+}
+
+// Sweep - Remove keys idle longer than their own window
+func (m *MemoryRateCounter) Sweep() {
+	now := time.Now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for k, e := range m.keys {
+		if len(e.events) == 0 || !e.events[len(e.events)-1].After(now.Add(-e.window)) {
+			delete(m.keys, k)
+		}
+	}
+}
+
+func trimEvents(events []time.Time, since time.Time) []time.Time {
+	i := 0
+	for i < len(events) && !events[i].After(since) {
+		i++
+	}
+	return events[i:]
+}
+
+// RedisRateCounter - Count with redis sorted set (shared with other instances)
+type RedisRateCounter struct {
+	pool *redis.Pool
+	seq  uint64
+}
+
+const redisRateKeyPrefix = "nebula:ratelimit:"
+
+func NewRedisRateCounter(server string) *RedisRateCounter {
+	return &RedisRateCounter{
+		pool: newRedisPool(server),
+	}
+}
+
+func (r *RedisRateCounter) Hit(key string, window time.Duration) (int64, error) {
+	c := r.pool.Get()
+	defer c.Close()
+
+	now := time.Now().UnixNano()
+	member := fmt.Sprintf("%d-%d", now, atomic.AddUint64(&r.seq, 1))
+	key = redisRateKeyPrefix + key
+
+	c.Send("MULTI")
+	c.Send("ZREMRANGEBYSCORE", key, "-inf", now-int64(window))
+	c.Send("ZADD", key, now, member)
+	c.Send("ZCARD", key)
+	c.Send("PEXPIRE", key, int64(window/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+
+	return redis.Int64(values[2], nil)
+}
+
+// LimitRule - Allow Limit events per Window (Limit 0: disabled)
+type LimitRule struct {
+	Limit  int64
+	Window time.Duration
+}
+
+// ParseLimitRule - Parse "<limit>/<window>" (e.g. 5/1m)
+func ParseLimitRule(s string) (LimitRule, error) {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return LimitRule{}, fmt.Errorf("invalid limit rule: %s", s)
+	}
+
+	limit, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return LimitRule{}, err
+	}
+	window, err := time.ParseDuration(parts[1])
+	if err != nil {
+		return LimitRule{}, err
+	}
+	if limit < 0 || (limit > 0 && window <= 0) {
+		return LimitRule{}, fmt.Errorf("invalid limit rule: %s", s)
+	}
+
+	return LimitRule{Limit: limit, Window: window}, nil
+}
+
+// ConnectionLimitConfig - Join rate limit config
+type ConnectionLimitConfig struct {
+	PerIP     LimitRule
+	PerSubnet LimitRule
+	Global    LimitRule
+	// BanDuration - temporary ban for address / subnet exceeded limit
+	BanDuration time.Duration
+}
+
+// ConnectionLimiter - Join rate limit per IP, per subnet (/24, /64) and global
+type ConnectionLimiter struct {
+	counter RateCounter
+	config  *ConnectionLimitConfig
+}
+
+// ConnectionLimitResult - Exceeded limit (Ban is empty when only rejected)
+type ConnectionLimitResult struct {
+	Allowed bool
+	Reason  string
+	Count   int64
+	// Ban - address or subnet to be banned
+	Ban string
+}
+
+func NewConnectionLimiter(counter RateCounter, config *ConnectionLimitConfig) *ConnectionLimiter {
+	if config.BanDuration <= 0 {
+		config.BanDuration = 10 * time.Minute
+	}
+
+	return &ConnectionLimiter{
+		counter: counter,
+		config:  config,
+	}
+}
+
+// BanDuration - Temporary ban duration
+func (l *ConnectionLimiter) BanDuration() time.Duration {
+	return l.config.BanDuration
+}
+
+// Hit - Record connection and check limits
+func (l *ConnectionLimiter) Hit(ip string) (*ConnectionLimitResult, error) {
+	prefix, err := ParsePrefix(ip)
+	if err != nil {
+		return nil, err
+	}
+	subnet := SubnetOf(prefix)
+
+	checks := []struct {
+		rule   LimitRule
+		key    string
+		reason string
+		ban    string
+	}{
+		{l.config.PerIP, "ip:" + prefix.String(), "IP_RATE_LIMIT", prefix.String()},
+		{l.config.PerSubnet, "subnet:" + subnet, "SUBNET_RATE_LIMIT", subnet},
+		{l.config.Global, "global", "GLOBAL_RATE_LIMIT", ""},
+	}
+
+	result := &ConnectionLimitResult{Allowed: true}
+	for _, c := range checks {
+		if c.rule.Limit <= 0 {
+			continue
+		}
+
+		count, err := l.counter.Hit(c.key, c.rule.Window)
+		if err != nil {
+			return nil, err
+		}
+		if result.Allowed && count > c.rule.Limit {
+			result.Allowed = false
+			result.Reason = c.reason
+			result.Count = count
+			result.Ban = c.ban
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLimitRule(t *testing.T) {
+	tests := []struct {
+		rule    string
+		want    LimitRule
+		wantErr bool
+	}{
+		{rule: "5/1m", want: LimitRule{Limit: 5, Window: time.Minute}},
+		{rule: "100/10s", want: LimitRule{Limit: 100, Window: 10 * time.Second}},
+		{rule: "0/1m", want: LimitRule{Limit: 0, Window: time.Minute}},
+		{rule: "0/0s", want: LimitRule{}},
+		{rule: "5", wantErr: true},
+		{rule: "five/1m", wantErr: true},
+		{rule: "5/minute", wantErr: true},
+		{rule: "-1/1m", wantErr: true},
+		{rule: "5/0s", wantErr: true},
+		{rule: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLimitRule(tt.rule)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseLimitRule(%q) = %+v, want error", tt.rule, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseLimitRule(%q) error: %s", tt.rule, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseLimitRule(%q) = %+v, want %+v", tt.rule, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryRateCounter(t *testing.T) {
+	counter := NewMemoryRateCounter()
+	window := 100 * time.Millisecond
+
+	for i := int64(1); i <= 3; i++ {
+		n, err := counter.Hit("a", window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != i {
+			t.Errorf("hit %d: count = %d", i, n)
+		}
+	}
+	if n, _ := counter.Hit("b", window); n != 1 {
+		t.Errorf("other key: count = %d, want 1", n)
+	}
+
+	// events older than window slide out
+	time.Sleep(window + 20*time.Millisecond)
+	if n, _ := counter.Hit("a", window); n != 1 {
+		t.Errorf("after window: count = %d, want 1", n)
+	}
+}
+
+func TestMemoryRateCounterMixedWindows(t *testing.T) {
+	counter := NewMemoryRateCounter()
+	long := time.Minute
+	short := 50 * time.Millisecond
+
+	for i := 0; i < 3; i++ {
+		counter.Hit("ip:192.0.2.1", long)
+	}
+	counter.Hit("global", short)
+
+	// global key expires with its short window, per IP key must survive
+	time.Sleep(short + 20*time.Millisecond)
+	counter.Hit("global", short)
+	counter.Sweep()
+
+	if n, _ := counter.Hit("ip:192.0.2.1", long); n != 4 {
+		t.Errorf("per IP count = %d, want 4", n)
+	}
+	if n, _ := counter.Hit("global", short); n != 2 {
+		t.Errorf("global count = %d, want 2", n)
+	}
+
+	time.Sleep(short + 20*time.Millisecond)
+	counter.Sweep()
+	counter.mu.Lock()
+	_, global := counter.keys["global"]
+	_, ip := counter.keys["ip:192.0.2.1"]
+	counter.mu.Unlock()
+	if global || !ip {
+		t.Errorf("after sweep: global kept = %v, ip kept = %v, want false, true", global, ip)
+	}
+}
+
+func TestConnectionLimiter(t *testing.T) {
+	limiter := NewConnectionLimiter(NewMemoryRateCounter(), &ConnectionLimitConfig{
+		PerIP:     LimitRule{Limit: 2, Window: time.Minute},
+		PerSubnet: LimitRule{Limit: 3, Window: time.Minute},
+	})
+
+	tests := []struct {
+		ip      string
+		allowed bool
+		reason  string
+		ban     string
+	}{
+		{ip: "192.0.2.1", allowed: true},
+		{ip: "192.0.2.1", allowed: true},
+		{ip: "192.0.2.1", reason: "IP_RATE_LIMIT", ban: "192.0.2.1/32"},
+		{ip: "192.0.2.2", reason: "SUBNET_RATE_LIMIT", ban: "192.0.2.0/24"},
+		{ip: "198.51.100.1", allowed: true},
+	}
+
+	for i, tt := range tests {
+		r, err := limiter.Hit(tt.ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Allowed != tt.allowed || r.Reason != tt.reason || r.Ban != tt.ban {
+			t.Errorf("hit %d (%s) = %+v, want allowed=%v reason=%q ban=%q", i, tt.ip, r, tt.allowed, tt.reason, tt.ban)
+		}
+	}
+
+	if _, err := limiter.Hit("invalid"); err == nil {
+		t.Error("invalid address accepted")
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+)
+
+// RedisLookupStore - Store lookup results in redis (expired by redis)
+type RedisLookupStore struct {
+	pool *redis.Pool
+}
+
+const redisLookupKeyPrefix = "nebula:iplookup:"
+
+// NewRedisLookupStore - redis Connection Pooling
+func NewRedisLookupStore(server string) *RedisLookupStore {
+	return &RedisLookupStore{
+		pool: newRedisPool(server),
+	}
+}
+
+func newRedisPool(server string) *redis.Pool {
+	return &redis.Pool{
+		MaxIdle:     4,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			c, err := redis.Dial("tcp", server,
+				redis.DialConnectTimeout(5*time.Second),
+				redis.DialReadTimeout(5*time.Second),
+				redis.DialWriteTimeout(5*time.Second),
+			)
+			if err != nil {
+				logrus.WithError(err).Errorf("[Redis] Error occurred in Connecting: %s", server)
+			}
+			return c, err
+		},
+	}
+}
+
+func (r *RedisLookupStore) Get(ip string) (*DBIPResult, error) {
+	c := r.pool.Get()
+	defer c.Close()
+
+	b, err := redis.Bytes(c.Do("GET", redisLookupKeyPrefix+ip))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var result *DBIPResult
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *RedisLookupStore) Set(ip string, result *DBIPResult, ttl time.Duration) error {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	c := r.pool.Get()
+	defer c.Close()
+
+	_, err = c.Do("SET", redisLookupKeyPrefix+ip, b, "EX", int64(ttl/time.Second))
+	if err != nil {
+		logrus.WithError(err).Errorf("[IPFilter] Failed store lookup result: %s", ip)
+	}
+	return err
+}
+
+func (r *RedisLookupStore) Purge() error {
+	return nil
+}
+
+// MysqlLookupStore - Store lookup results in mysql
+type MysqlLookupStore struct {
+	mysql *database.Mysql
+}
+
+func NewMysqlLookupStore(mysql *database.Mysql) *MysqlLookupStore {
+	return &MysqlLookupStore{mysql: mysql}
+}
+
+func (m *MysqlLookupStore) Get(ip string) (*DBIPResult, error) {
+	r, err := m.mysql.GetIPLookupCache(ip)
+	if err != nil || len(r) == 0 {
+		return nil, err
+	}
+
+	var result *DBIPResult
+	if err := json.Unmarshal([]byte(r), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (m *MysqlLookupStore) Set(ip string, result *DBIPResult, ttl time.Duration) error {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return m.mysql.SetIPLookupCache(ip, string(b), time.Now().Add(ttl))
+}
+
+func (m *MysqlLookupStore) Purge() error {
+	_, err := m.mysql.PurgeIPLookupCache(time.Now())
+	return err
+}
@@ -0,0 +1,16 @@
+package stream
+
+import (
+	"github.com/synchthia/nebula-api/nebulapb"
+	"google.golang.org/protobuf/proto"
+)
+
+const BroadcastChannel = "nebula.broadcast.global"
+
+// BroadcastMessage - Send broadcast to proxies
+func BroadcastMessage(data *nebulapb.Broadcast, announcementId int64) (string, proto.Message) {
+	return BroadcastChannel, &nebulapb.BroadcastStream{
+		Broadcast:      data,
+		AnnouncementId: announcementId,
+	}
+}
@@ -0,0 +1,39 @@
+package stream
+
+import (
+	"fmt"
+
+	"github.com/synchthia/nebula-api/database"
+	"github.com/synchthia/nebula-api/nebulapb"
+)
+
+// StreamEvent - Convert outbox entry to StreamEvent
+func StreamEvent(entry database.Outbox) (*nebulapb.StreamEvent, error) {
+	msg, err := entry.Message()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &nebulapb.StreamEvent{
+		Sequence: entry.Id,
+		Channel:  entry.Channel,
+	}
+	switch m := msg.(type) {
+	case *nebulapb.ServerEntryStream:
+		e.Message = &nebulapb.StreamEvent_Server{Server: m}
+	case *nebulapb.BungeeEntryStream:
+		e.Message = &nebulapb.StreamEvent_Bungee{Bungee: m}
+	case *nebulapb.PlayerPropertiesStream:
+		e.Message = &nebulapb.StreamEvent_Player{Player: m}
+	case *nebulapb.BroadcastStream:
+		e.Message = &nebulapb.StreamEvent_Broadcast{Broadcast: m}
+	case *nebulapb.ProxyStream:
+		e.Message = &nebulapb.StreamEvent_Proxy{Proxy: m}
+	case *nebulapb.PunishmentStream:
+		e.Message = &nebulapb.StreamEvent_Punishment{Punishment: m}
+	default:
+		return nil, fmt.Errorf("unknown stream message: %s", entry.MessageType)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,73 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec - Stream payload encoding
+type Codec struct {
+	Name        string
+	ContentType string
+	// Suffix - appended to channel name, so subscribers choose encoding by channel.
+	// Legacy json keeps the original channel name.
+	Suffix  string
+	Marshal func(proto.Message) ([]byte, error)
+}
+
+var (
+	// CodecJSON - encoding/json (legacy)
+	CodecJSON = &Codec{
+		Name:        "json",
+		ContentType: "application/json",
+		Marshal: func(m proto.Message) ([]byte, error) {
+			return json.Marshal(m)
+		},
+	}
+
+	// CodecProtoJSON - protojson (same field / enum names as generated classes)
+	CodecProtoJSON = &Codec{
+		Name:        "protojson",
+		ContentType: "application/protobuf+json",
+		Suffix:      ".protojson",
+		Marshal: func(m proto.Message) ([]byte, error) {
+			return protojson.Marshal(m)
+		},
+	}
+
+	// CodecProto - binary protobuf
+	CodecProto = &Codec{
+		Name:        "proto",
+		ContentType: "application/protobuf",
+		Suffix:      ".proto",
+		Marshal: func(m proto.Message) ([]byte, error) {
+			return proto.Marshal(m)
+		},
+	}
+)
+
+// ParseCodecs - Parse comma separated codec names (json, protojson, proto)
+func ParseCodecs(s string) ([]*Codec, error) {
+	if len(s) == 0 {
+		return []*Codec{CodecJSON}, nil
+	}
+
+	var codecs []*Codec
+	for _, name := range strings.Split(s, ",") {
+		switch strings.TrimSpace(name) {
+		case CodecJSON.Name:
+			codecs = append(codecs, CodecJSON)
+		case CodecProtoJSON.Name:
+			codecs = append(codecs, CodecProtoJSON)
+		case CodecProto.Name:
+			codecs = append(codecs, CodecProto)
+		default:
+			return nil, fmt.Errorf("unknown encoding: %s", name)
+		}
+	}
+	return codecs, nil
+}
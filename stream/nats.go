@@ -0,0 +1,70 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const natsFlushTimeout = 5 * time.Second
+
+// NATSConfig - NATS publish config
+type NATSConfig struct {
+	// Codecs - payload encodings (published to each subject)
+	Codecs []*Codec
+}
+
+// NATSPublisher - Publish messages with NATS (subject = channel name)
+type NATSPublisher struct {
+	conn   *nats.Conn
+	config *NATSConfig
+}
+
+func NewNATSPublisher(url string, config *NATSConfig) (*NATSPublisher, error) {
+	logrus.WithFields(logrus.Fields{
+		"url": url,
+	}).Infof("[NATS] Connecting to NATS...")
+
+	conn, err := nats.Connect(url,
+		nats.Name("nebula-api"),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(2*time.Second),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			logrus.WithError(err).Warnf("[NATS] Disconnected")
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			logrus.Infof("[NATS] Reconnected: %s", c.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSPublisher{
+		conn:   conn,
+		config: config,
+	}, nil
+}
+
+func (p *NATSPublisher) Publish(channel string, sequence uint64, msg proto.Message) error {
+	for _, codec := range p.config.Codecs {
+		payload, err := codec.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		if err := p.conn.Publish(channel+codec.Suffix, payload); err != nil {
+			return err
+		}
+	}
+
+	// make sure messages reached the server before marked as published
+	return p.conn.FlushTimeout(natsFlushTimeout)
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	outboxBatchSize  = 100
+	outboxMaxBackoff = 30 * time.Second
+	// outboxLeaseTTL - must be longer than publishing one message
+	outboxLeaseTTL = 30 * time.Second
+)
+
+var errOutboxLeaseLost = errors.New("outbox lease lost")
+
+// OutboxConfig - Outbox publisher config
+type OutboxConfig struct {
+	// Interval - polling interval
+	Interval time.Duration
+	// Retention - keep published messages for this duration
+	Retention time.Duration
+}
+
+// StartOutbox - Drain outbox in background and publish messages in order.
+// Messages are marked as published only after publish succeeded, so they are
+// delivered at least once (subscribers drop duplicates by sequence).
+// Failed message blocks the following ones until retry succeeds.
+// Publishing runs outside of any transaction, so a hanging broker never blocks Enqueue.
+// Only the lease owner publishes, and the lease is renewed before every message.
+func StartOutbox(mysql *database.Mysql, publisher Publisher, config *OutboxConfig) chan struct{} {
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano())
+
+	quit := make(chan struct{})
+	go func() {
+		backoff := config.Interval
+		purgeTicker := time.NewTicker(1 * time.Minute)
+		defer purgeTicker.Stop()
+
+		for {
+			select {
+			case <-quit:
+				return
+			case <-purgeTicker.C:
+				if n, err := mysql.PurgeOutbox(time.Now().Add(-config.Retention)); err != nil {
+					logrus.WithError(err).Errorf("[Outbox] Failed purge outbox")
+				} else if n > 0 {
+					logrus.Debugf("[Outbox] Purged %d messages", n)
+				}
+			case <-time.After(backoff):
+				leader, err := mysql.AcquireOutboxLease(owner, outboxLeaseTTL)
+				if err != nil {
+					logrus.WithError(err).Errorf("[Outbox] Failed acquire lease")
+				}
+				if !leader {
+					backoff = config.Interval
+					continue
+				}
+
+				n, err := drainOutbox(mysql, publisher, owner)
+				if errors.Is(err, errOutboxLeaseLost) {
+					logrus.Warnf("[Outbox] Lost publisher lease after %d messages", n)
+					backoff = config.Interval
+					continue
+				} else if err != nil {
+					backoff *= 2
+					if backoff > outboxMaxBackoff {
+						backoff = outboxMaxBackoff
+					}
+					logrus.WithError(err).Warnf("[Outbox] Publish failed, retrying in %s", backoff)
+					continue
+				}
+
+				backoff = config.Interval
+				if n == outboxBatchSize {
+					// more messages may be waiting
+					backoff = 0
+				}
+			}
+		}
+	}()
+	return quit
+}
+
+// drainOutbox - Publish one batch of pending messages (each message is marked after publish)
+func drainOutbox(mysql *database.Mysql, publisher Publisher, owner string) (int, error) {
+	entries, err := mysql.GetPendingOutbox(outboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, entry := range entries {
+		// single publish is shorter than lease TTL (broker timeouts), so no one else publishes meanwhile
+		if leader, err := mysql.AcquireOutboxLease(owner, outboxLeaseTTL); err != nil {
+			return published, err
+		} else if !leader {
+			return published, errOutboxLeaseLost
+		}
+
+		msg, err := entry.Message()
+		if err != nil {
+			// undecodable message never succeeds, skip it instead of blocking the queue
+			logrus.WithError(err).Errorf("[Outbox] Dropped broken message: %d", entry.Id)
+			if err := mysql.MarkOutboxFailed(entry.Id, err); err != nil {
+				return published, err
+			}
+		} else if pubErr := publishMessage(publisher, entry.Channel, entry.Id, msg); pubErr != nil {
+			if err := mysql.MarkOutboxFailed(entry.Id, pubErr); err != nil {
+				logrus.WithError(err).Errorf("[Outbox] Failed mark outbox: %d", entry.Id)
+			}
+			return published, pubErr
+		}
+
+		if err := mysql.MarkOutboxPublished(entry.Id); err != nil {
+			return published, err
+		}
+		published++
+	}
+
+	return published, nil
+}
+
+func publishMessage(publisher Publisher, channel string, sequence uint64, msg proto.Message) error {
+	logrus.Debugln(msg)
+
+	if err := publisher.Publish(channel, sequence, msg); err != nil {
+		logrus.WithError(err).Errorf("[Publish] Failed Publish %s", msg.ProtoReflect().Descriptor().Name())
+		return err
+	}
+	return nil
+}
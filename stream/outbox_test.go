@@ -0,0 +1,87 @@
+package stream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/synchthia/nebula-api/database"
+	"github.com/synchthia/nebula-api/nebulapb"
+	"google.golang.org/protobuf/proto"
+)
+
+func outboxEntry(t *testing.T, id uint64, channel string, msg proto.Message) database.Outbox {
+	t.Helper()
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return database.Outbox{
+		Id:          id,
+		Channel:     channel,
+		MessageType: string(msg.ProtoReflect().Descriptor().FullName()),
+		Payload:     b,
+		CreatedAt:   time.Unix(1700000000, 0),
+	}
+}
+
+func TestPublishOutboxEntry(t *testing.T) {
+	publisher := NewMemoryPublisher()
+
+	serverChannel, serverMsg := ServerMessage(&nebulapb.ServerEntry{Name: "lobby"}, 3)
+	entries := []database.Outbox{
+		outboxEntry(t, 10, serverChannel, serverMsg),
+		outboxEntry(t, 11, PlayerStaffChannel, PlayerProfileMessage(nebulapb.PlayerPropertiesStream_JOIN_SOLO, &nebulapb.PlayerProfile{PlayerName: "alice"}, 1)),
+	}
+
+	for _, entry := range entries {
+		msg, err := entry.Message()
+		if err != nil {
+			t.Fatalf("decode %d: %s", entry.Id, err)
+		}
+		if err := publishMessage(publisher, entry.Channel, entry.Id, msg); err != nil {
+			t.Fatalf("publish %d: %s", entry.Id, err)
+		}
+	}
+
+	if n := len(publisher.Messages("")); n != 2 {
+		t.Fatalf("published %d messages, want 2", n)
+	}
+
+	servers := publisher.Messages(ServerChannel)
+	if len(servers) != 1 {
+		t.Fatalf("published %d server messages, want 1", len(servers))
+	}
+	server, ok := servers[0].Message.(*nebulapb.ServerEntryStream)
+	if !ok {
+		t.Fatalf("unexpected message type %T", servers[0].Message)
+	}
+	if server.Sequence != 10 || servers[0].Sequence != 10 {
+		t.Errorf("sequence = %d / %d, want 10", server.Sequence, servers[0].Sequence)
+	}
+	if server.Timestamp != 1700000000000 {
+		t.Errorf("timestamp = %d, want 1700000000000", server.Timestamp)
+	}
+	if server.Revision != 3 || server.Entry.GetName() != "lobby" {
+		t.Errorf("unexpected server message: %v", server)
+	}
+
+	players := publisher.Messages(PlayerStaffChannel)
+	if len(players) != 1 || players[0].Sequence != 11 {
+		t.Fatalf("unexpected player messages: %v", players)
+	}
+
+	publisher.Reset()
+	if n := len(publisher.Messages("")); n != 0 {
+		t.Errorf("%d messages left after reset", n)
+	}
+}
+
+func TestOutboxUnknownMessage(t *testing.T) {
+	entry := outboxEntry(t, 1, ServerChannel, &nebulapb.ServerEntry{Name: "lobby"})
+	entry.MessageType = "nebulapb.Unknown"
+
+	if _, err := entry.Message(); err == nil {
+		t.Error("decoded unknown message type")
+	}
+}
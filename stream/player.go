@@ -1,59 +1,42 @@
 package stream
 
 import (
-	"encoding/json"
-	"errors"
-
-	"github.com/sirupsen/logrus"
+	"github.com/synchthia/nebula-api/database"
 	"github.com/synchthia/nebula-api/nebulapb"
 )
 
-// PublishPlayerProfile - Stream for tablist
-func PublishPlayerProfile(streamType nebulapb.PlayerPropertiesStream_Type, data *nebulapb.PlayerProfile) error {
-	c := pool.Get()
-	defer c.Close()
-
-	if streamType != nebulapb.PlayerPropertiesStream_JOIN_SOLO && streamType != nebulapb.PlayerPropertiesStream_QUIT_SOLO {
-		return errors.New("seriously?")
-	}
-
-	d := &nebulapb.PlayerPropertiesStream{
-		Type: streamType,
-		Solo: data,
-	}
-
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
+const (
+	PlayerChannel = "nebula.player.global"
+	// PlayerStaffChannel - includes hidden (vanished) players
+	PlayerStaffChannel = "nebula.player.staff"
+)
 
-	_, err := c.Do("PUBLISH", "nebula.player.global", string(serialized))
-	if err != nil {
-		logrus.WithError(err).Errorf("[Publish] Failed Publish Player Profile")
-		return err
+// PlayerProfileMessage - Stream for tablist
+func PlayerProfileMessage(streamType nebulapb.PlayerPropertiesStream_Type, data *nebulapb.PlayerProfile, revision int64) *nebulapb.PlayerPropertiesStream {
+	return &nebulapb.PlayerPropertiesStream{
+		Type:     streamType,
+		Solo:     data,
+		Revision: revision,
 	}
-	return nil
 }
 
-// PublishAllPlayerProfile
-func PublicAllPlayerProfile(streamType nebulapb.PlayerPropertiesStream_Type, data []*nebulapb.PlayerProfile) error {
-	c := pool.Get()
-	defer c.Close()
-
-	if streamType != nebulapb.PlayerPropertiesStream_ADVERTISE_ALL {
-		return errors.New("seriously?")
-	}
-
-	d := &nebulapb.PlayerPropertiesStream{
-		Type: streamType,
-		All:  data,
+// PlayerSwitchMessage - Stream for tablist (player moved to another server)
+func PlayerSwitchMessage(data *nebulapb.PlayerProfile, fromServer string, revision int64) *nebulapb.PlayerPropertiesStream {
+	return &nebulapb.PlayerPropertiesStream{
+		Type:       nebulapb.PlayerPropertiesStream_SWITCH_SOLO,
+		Solo:       data,
+		Revision:   revision,
+		FromServer: fromServer,
 	}
+}
 
-	serialized, _ := json.Marshal(&d)
-	logrus.Debugln(d)
-
-	_, err := c.Do("PUBLISH", "nebula.player.global", string(serialized))
-	if err != nil {
-		logrus.WithError(err).Errorf("[Publish] Failed Publish Player Profile")
+// EnqueuePlayer - Enqueue player message to staff channel, and public channel unless player is hidden
+func EnqueuePlayer(tx *database.Mysql, msg *nebulapb.PlayerPropertiesStream) error {
+	if err := tx.Enqueue(PlayerStaffChannel, msg); err != nil {
 		return err
 	}
-	return nil
+	if msg.Solo != nil && msg.Solo.Hide {
+		return nil
+	}
+	return tx.Enqueue(PlayerChannel, msg)
 }
@@ -0,0 +1,50 @@
+package stream
+
+import (
+	"github.com/synchthia/nebula-api/nebulapb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ProxyChannelPrefix = "nebula.proxy."
+	// ProxyGlobalChannel - for players whose proxy is unknown
+	ProxyGlobalChannel = ProxyChannelPrefix + "global"
+)
+
+// ProxyChannel - Channel for the proxy
+func ProxyChannel(proxyID string) string {
+	if len(proxyID) == 0 {
+		return ProxyGlobalChannel
+	}
+	return ProxyChannelPrefix + proxyID
+}
+
+// PlayerMessageMessage - Send chat message to player via the proxy
+func PlayerMessageMessage(proxyID, playerUUID, message, senderUUID, senderName string) (string, proto.Message) {
+	return ProxyChannel(proxyID), &nebulapb.ProxyStream{
+		Type:       nebulapb.ProxyStream_MESSAGE,
+		PlayerUUID: playerUUID,
+		Message:    message,
+		SenderUUID: senderUUID,
+		SenderName: senderName,
+	}
+}
+
+// PlayerTransferMessage - Connect player to the server via the proxy
+func PlayerTransferMessage(proxyID, playerUUID, targetServer string) (string, proto.Message) {
+	return ProxyChannel(proxyID), &nebulapb.ProxyStream{
+		Type:         nebulapb.ProxyStream_TRANSFER,
+		PlayerUUID:   playerUUID,
+		TargetServer: targetServer,
+	}
+}
+
+// PlayerKickMessage - Disconnect player via the proxy
+func PlayerKickMessage(proxyID, playerUUID, reason string, punishment *nebulapb.Punishment) (string, proto.Message) {
+	return ProxyChannel(proxyID), &nebulapb.ProxyStream{
+		Type:       nebulapb.ProxyStream_KICK,
+		PlayerUUID: playerUUID,
+		Message:    reason,
+		Punishment: punishment,
+	}
+}
@@ -0,0 +1,68 @@
+package stream
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Publisher - Publish stream messages to subscribers
+type Publisher interface {
+	Publish(channel string, sequence uint64, msg proto.Message) error
+	Close() error
+}
+
+// PublishedMessage - Message recorded by MemoryPublisher
+type PublishedMessage struct {
+	Channel  string
+	Sequence uint64
+	Message  proto.Message
+}
+
+// MemoryPublisher - Publisher which keeps messages in memory (for tests only, messages are never dropped)
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []PublishedMessage
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(channel string, sequence uint64, msg proto.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, PublishedMessage{
+		Channel:  channel,
+		Sequence: sequence,
+		Message:  proto.Clone(msg),
+	})
+	return nil
+}
+
+// Messages - Get published messages (all channels when channel is empty)
+func (p *MemoryPublisher) Messages(channel string) []PublishedMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var messages []PublishedMessage
+	for _, m := range p.messages {
+		if len(channel) == 0 || m.Channel == channel {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+// Reset - Clear published messages
+func (p *MemoryPublisher) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = nil
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,16 @@
+package stream
+
+import (
+	"github.com/synchthia/nebula-api/nebulapb"
+	"google.golang.org/protobuf/proto"
+)
+
+const PunishmentChannel = "nebula.punishment.global"
+
+// PunishmentMessage - Notify punished / revoked
+func PunishmentMessage(t nebulapb.PunishmentStream_Type, data *nebulapb.Punishment) (string, proto.Message) {
+	return PunishmentChannel, &nebulapb.PunishmentStream{
+		Type:       t,
+		Punishment: data,
+	}
+}
@@ -1,28 +1,75 @@
 package stream
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
-var pool *redis.Pool
+// Transport - How messages are written to redis
+type Transport int
 
-// NewRedisPool - redis Connection Pooling
-func NewRedisPool(server string) {
+const (
+	// TransportPubSub - PUBLISH only (fire-and-forget)
+	TransportPubSub Transport = iota
+	// TransportStreams - XADD only (subscribers can replay from last ID)
+	TransportStreams
+	// TransportBoth - PUBLISH and XADD
+	TransportBoth
+)
+
+// ParseTransport - Parse transport name (pubsub, streams, both)
+func ParseTransport(s string) (Transport, error) {
+	switch s {
+	case "", "pubsub":
+		return TransportPubSub, nil
+	case "streams":
+		return TransportStreams, nil
+	case "both":
+		return TransportBoth, nil
+	}
+	return TransportPubSub, fmt.Errorf("unknown transport: %s", s)
+}
+
+// redisTimeout - connect / read / write timeout (hanging redis fails publish instead of blocking)
+const redisTimeout = 5 * time.Second
+
+// RedisConfig - Redis publish config
+type RedisConfig struct {
+	Transport Transport
+	// StreamMaxLen - approximate max length of each redis stream
+	StreamMaxLen int64
+	// Codecs - payload encodings (published to each channel)
+	Codecs []*Codec
+}
+
+// RedisPublisher - Publish messages with redis
+type RedisPublisher struct {
+	pool   *redis.Pool
+	config *RedisConfig
+}
+
+// NewRedisPublisher - redis Connection Pooling
+func NewRedisPublisher(server string, config *RedisConfig) *RedisPublisher {
 	logrus.WithFields(logrus.Fields{
 		"server": server,
 	}).Infof("[Redis] Creating Pool...")
 
-	pool = &redis.Pool{
+	pool := &redis.Pool{
 		MaxIdle:   12,
 		MaxActive: 0,
 		//IdleTimeout: 240 * time.Second,
 		Wait: true,
 
 		Dial: func() (redis.Conn, error) {
-			c, err := redis.Dial("tcp", server)
+			c, err := redis.Dial("tcp", server,
+				redis.DialConnectTimeout(redisTimeout),
+				redis.DialReadTimeout(redisTimeout),
+				redis.DialWriteTimeout(redisTimeout),
+			)
 
 			if err != nil {
 				logrus.WithError(err).Errorf("[Redis] Error occurred in Connecting: %s", server)
@@ -40,4 +87,46 @@ func NewRedisPool(server string) {
 			return err
 		},
 	}
+
+	return &RedisPublisher{
+		pool:   pool,
+		config: config,
+	}
+}
+
+// Publish - Write message to channel (PUBLISH and/or XADD) with each codec
+func (p *RedisPublisher) Publish(channel string, sequence uint64, msg proto.Message) error {
+	c := p.pool.Get()
+	defer c.Close()
+
+	for _, codec := range p.config.Codecs {
+		payload, err := codec.Marshal(msg)
+		if err != nil {
+			return err
+		}
+
+		if p.config.Transport != TransportStreams {
+			if _, err := c.Do("PUBLISH", channel+codec.Suffix, payload); err != nil {
+				return err
+			}
+		}
+
+		if p.config.Transport != TransportPubSub {
+			// Stream IDs are generated by redis, since outbox sequence may arrive out of order
+			args := redis.Args{}.Add(channel + codec.Suffix)
+			if p.config.StreamMaxLen > 0 {
+				args = args.Add("MAXLEN", "~", p.config.StreamMaxLen)
+			}
+			args = args.Add("*", "sequence", sequence, "content-type", codec.ContentType, "payload", payload)
+			if _, err := c.Do("XADD", args...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *RedisPublisher) Close() error {
+	return p.pool.Close()
 }
@@ -1,48 +1,26 @@
 package stream
 
 import (
-	"encoding/json"
-
 	"github.com/synchthia/nebula-api/nebulapb"
-
-	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
-// PublishServer - Publish with Redis
-func PublishServer(data *nebulapb.ServerEntry) error {
-	c := pool.Get()
-	defer c.Close()
-
-	d := &nebulapb.ServerEntryStream{
-		Type:  nebulapb.ServerEntryStream_SYNC,
-		Entry: data,
-	}
-	serialized, _ := json.Marshal(&d)
-	//logrus.Debugln(d)
+const ServerChannel = "nebula.servers.global"
 
-	_, err := c.Do("PUBLISH", "nebula.servers.global", string(serialized))
-	if err != nil {
-		logrus.WithError(err).Errorf("[Publish] Failed Publish Server")
-		return err
+// ServerMessage - Sync ServerEntry to proxies
+func ServerMessage(data *nebulapb.ServerEntry, revision int64) (string, proto.Message) {
+	return ServerChannel, &nebulapb.ServerEntryStream{
+		Type:     nebulapb.ServerEntryStream_SYNC,
+		Entry:    data,
+		Revision: revision,
 	}
-	return nil
 }
 
-// PublishRemoveServer - Remove Server send Redis
-func PublishRemoveServer(data *nebulapb.ServerEntry) error {
-	c := pool.Get()
-	defer c.Close()
-
-	d := &nebulapb.ServerEntryStream{
-		Type:  nebulapb.ServerEntryStream_REMOVE,
-		Entry: data,
-	}
-	serialized, _ := json.Marshal(&d)
-	//logrus.Debugln(data)
-	_, err := c.Do("PUBLISH", "nebula.servers.global", string(serialized))
-	if err != nil {
-		logrus.WithError(err).Errorf("[Publish] Failed Remove Server")
-		return err
+// RemoveServerMessage - Remove ServerEntry from proxies
+func RemoveServerMessage(data *nebulapb.ServerEntry, revision int64) (string, proto.Message) {
+	return ServerChannel, &nebulapb.ServerEntryStream{
+		Type:     nebulapb.ServerEntryStream_REMOVE,
+		Entry:    data,
+		Revision: revision,
 	}
-	return nil
 }